@@ -10,8 +10,9 @@ provider "ctfd" {
   url = "http://localhost:8080"
 }
 
-resource "ctfd_challenge_dynamic" "idor_challenge" {
+resource "ctfd_challenge" "idor_challenge" {
   name        = "IDOR Rendezvous"
+  type        = "dynamic"
   category    = "web"
   description = <<-EOT
         Sample description for IDOR Rendezvous challenge.
@@ -36,28 +37,29 @@ resource "ctfd_challenge_dynamic" "idor_challenge" {
 }
 
 resource "ctfd_hint" "idor_challenge_hint_1" {
-  challenge_id = ctfd_challenge_dynamic.idor_challenge.id
+  challenge_id = ctfd_challenge.idor_challenge.id
   content      = "Les flux http ne sont pas chiffrés"
   cost         = 50
 }
 
 resource "ctfd_hint" "idor_challenge_hint_2" {
-  challenge_id = ctfd_challenge_dynamic.idor_challenge.id
+  challenge_id = ctfd_challenge.idor_challenge.id
   content      = "Les informations sont POSTées en HTTP :)"
   cost         = 50
   requirements = [ctfd_hint.http_hint_1.id]
 }
 
 resource "ctfd_file" "idor_challenge_file" {
-  challenge_id = ctfd_challenge_dynamic.idor_challenge.id
+  challenge_id = ctfd_challenge.idor_challenge.id
   name         = "capture.pcapng"
   contentb64   = filebase64("${path.module}/capture.pcapng")
 }
 
 
 
-resource "ctfd_challenge_dynamic" "icmp_challenge" {
+resource "ctfd_challenge" "icmp_challenge" {
   name        = "Stealing data"
+  type        = "dynamic"
   category    = "forensics"
   description = <<-EOT
         Sample description
@@ -69,7 +71,7 @@ resource "ctfd_challenge_dynamic" "icmp_challenge" {
   state       = "visible"
   requirements = {
     behavior      = "anonymized"
-    prerequisites = [ctfd_challenge_dynamic.idor_challenge.id]
+    prerequisites = [ctfd_challenge.idor_challenge.id]
   }
 
   flags = [{
@@ -86,20 +88,20 @@ resource "ctfd_challenge_dynamic" "icmp_challenge" {
 }
 
 resource "ctfd_hint" "icmp_challenge_hint_1" {
-  challenge_id = ctfd_challenge_dynamic.icmp_challenge.id
+  challenge_id = ctfd_challenge.icmp_challenge.id
   content      = "Vous ne trouvez pas qu'il ya beaucoup de requêtes ICMP ?"
   cost         = 50
 }
 
 resource "ctfd_hint" "icmp_challenge_hint_2" {
-  challenge_id = ctfd_challenge_dynamic.icmp_challenge.id
+  challenge_id = ctfd_challenge.icmp_challenge.id
   content      = "Pour l'exo, le ttl a été modifié, tente un `ip.ttl<=20`"
   cost         = 50
   requirements = [ctfd_hint.icmp_hint_2.id]
 }
 
 resource "ctfd_file" "icmp_challenge_file" {
-  challenge_id = ctfd_challenge_dynamic.icmp_challenge.id
+  challenge_id = ctfd_challenge.icmp_challenge.id
   name         = "icmp.pcap"
   contentb64   = filebase64("${path.module}/icmp.pcap")
 }
@@ -0,0 +1,19 @@
+variable "bot_password" {
+  type      = string
+  sensitive = true
+}
+
+# CTFd mints tokens for the authenticated user only, so the token of a
+# bot is created by a provider authenticated as this bot.
+provider "ctfd" {
+  alias    = "bot"
+  url      = "https://my-ctfd.lan"
+  username = "discord-bot"
+  password = var.bot_password
+}
+
+resource "ctfd_token" "bot" {
+  provider    = ctfd.bot
+  description = "Discord bot"
+  expiration  = "2027-01-01"
+}
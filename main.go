@@ -4,8 +4,10 @@ import (
 	"context"
 	"flag"
 	"log"
+	"time"
 
 	"github.com/AlexEreh/terraform-provider-ctfd/provider"
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/utils"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 )
 
@@ -34,6 +36,14 @@ func main() {
 
 	err := providerserver.Serve(context.Background(), provider.New(version), opts)
 
+	// Flush the telemetry before exiting, Terraform kills the provider
+	// 2 seconds after asking it to stop
+	ctx, cancel := context.WithTimeout(context.Background(), 1500*time.Millisecond)
+	if serr := utils.ShutdownTelemetry(ctx); serr != nil {
+		log.Print(serr.Error())
+	}
+	cancel()
+
 	if err != nil {
 		log.Fatal(err.Error())
 	}
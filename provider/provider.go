@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/ctfer-io/go-ctfd/api"
@@ -12,15 +13,26 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/resources/award"
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/resources/bracket"
 	"github.com/AlexEreh/terraform-provider-ctfd/provider/resources/challenge"
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/resources/config"
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/resources/flag"
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/resources/hint"
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/resources/notification"
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/resources/page"
 	"github.com/AlexEreh/terraform-provider-ctfd/provider/resources/solution"
 	"github.com/AlexEreh/terraform-provider-ctfd/provider/resources/team"
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/resources/token"
 	"github.com/AlexEreh/terraform-provider-ctfd/provider/resources/user"
 	"github.com/AlexEreh/terraform-provider-ctfd/provider/utils"
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/validators"
 )
 
 var _ provider.Provider = (*CTFdProvider)(nil)
@@ -42,6 +54,32 @@ type CTFdProviderModel struct {
 	APIKey   types.String `tfsdk:"api_key"`
 	Username types.String `tfsdk:"username"`
 	Password types.String `tfsdk:"password"`
+
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	ClientCert         types.String `tfsdk:"client_cert"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ProxyURL           types.String `tfsdk:"proxy_url"`
+
+	Headers   map[string]types.String `tfsdk:"headers"`
+	BasicAuth *basicAuthModel         `tfsdk:"basic_auth"`
+
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryMinWait types.String `tfsdk:"retry_min_wait"`
+	RetryMaxWait types.String `tfsdk:"retry_max_wait"`
+
+	Telemetry *telemetryModel `tfsdk:"telemetry"`
+}
+
+type basicAuthModel struct {
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
+type telemetryModel struct {
+	Endpoint types.String `tfsdk:"endpoint"`
+	Protocol types.String `tfsdk:"protocol"`
 }
 
 func (p *CTFdProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -69,7 +107,8 @@ You must configure the provider with the proper credentials before you can use i
 
 If you are using the username/password configuration, remember that CTFd comes with a
 ratelimiter on rare methods and endpoints, but ` + "`POST /login`" + ` is one of them.
-This could lead to unexpected failures under intensive work.
+This could lead to unexpected failures under intensive work, which are mitigated by retrying
+ratelimited requests (see ` + "`max_retries`" + `).
 
 !> **Warning:** Hard-coded credentials are not recommended in any Terraform
 configuration and risks secret leakage should this file ever be committed to a
@@ -81,7 +120,7 @@ public version control system.
 				Optional:            true,
 			},
 			"api_key": schema.StringAttribute{
-				MarkdownDescription: "User API key. Could use `CTFD_API_KEY` environment variable instead. Despite being the most convenient way to authenticate yourself, we do not recommend it as you will probably generate a long-live token without any rotation policy.",
+				MarkdownDescription: "User API key. Could use `CTFD_API_KEY` environment variable instead. Despite being the most convenient way to authenticate yourself, we do not recommend it as you will probably generate a long-live token without any rotation policy. Prefer minting a dedicated one with `ctfd_token`, and rotate it with `terraform apply -replace`.",
 				Sensitive:           true,
 				Optional:            true,
 			},
@@ -95,6 +134,85 @@ public version control system.
 				Sensitive:           true,
 				Optional:            true,
 			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM-encoded CA bundle to trust in addition to the system ones, e.g. for an internal CA. Could use `CTFD_CA_CERT_FILE` environment variable instead.",
+				Optional:            true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded CA bundle to trust in addition to the system ones. Could use `CTFD_CA_CERT_PEM` environment variable instead.",
+				Optional:            true,
+			},
+			"client_cert": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded client certificate, or path to it, for mTLS. Could use `CTFD_CLIENT_CERT` environment variable instead.",
+				Optional:            true,
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded client private key, or path to it, for mTLS. Could use `CTFD_CLIENT_KEY` environment variable instead.",
+				Sensitive:           true,
+				Optional:            true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip the verification of the CTFd TLS certificate. Should only be used for testing purposes. Could use `CTFD_INSECURE_SKIP_VERIFY` environment variable instead.",
+				Optional:            true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy to reach CTFd through (e.g. `http://proxy.lan:3128`). Could use `CTFD_PROXY_URL` environment variable instead, else default to `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` ones.",
+				Optional:            true,
+			},
+			"headers": schema.MapAttribute{
+				MarkdownDescription: "Headers to send with every request, e.g. the service token of an identity-aware proxy in front of CTFd.",
+				ElementType:         types.StringType,
+				Sensitive:           true,
+				Optional:            true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of retries of a request on a transient error (e.g. ratelimited, bad gateway). Non-idempotent requests are only retried when ratelimited. Default to 3, set to 0 to disable.",
+				Optional:            true,
+			},
+			"retry_min_wait": schema.StringAttribute{
+				MarkdownDescription: "Minimum time to wait before retrying a request, as a Go duration (e.g. `500ms`). Default to `1s`.",
+				Optional:            true,
+			},
+			"retry_max_wait": schema.StringAttribute{
+				MarkdownDescription: "Maximum time to wait before retrying a request, as a Go duration (e.g. `1m`). Default to `30s`. A `Retry-After` header returned by CTFd takes precedence.",
+				Optional:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"basic_auth": schema.SingleNestedBlock{
+				MarkdownDescription: "HTTP basic auth credentials to send with every request, e.g. for an access gateway in front of CTFd. As CTFd API keys use the same header, it can only be used with a username/password login.",
+				Attributes: map[string]schema.Attribute{
+					"username": schema.StringAttribute{
+						MarkdownDescription: "Basic auth username.",
+						Sensitive:           true,
+						Optional:            true,
+					},
+					"password": schema.StringAttribute{
+						MarkdownDescription: "Basic auth password.",
+						Sensitive:           true,
+						Optional:            true,
+					},
+				},
+			},
+			"telemetry": schema.SingleNestedBlock{
+				MarkdownDescription: "Export of the provider traces and metrics to an OpenTelemetry collector through OTLP. The standard `OTEL_*` environment variables are supported too, e.g. `OTEL_EXPORTER_OTLP_ENDPOINT` or `OTEL_EXPORTER_OTLP_HEADERS`. Nothing is exported without an endpoint.",
+				Attributes: map[string]schema.Attribute{
+					"endpoint": schema.StringAttribute{
+						MarkdownDescription: "Base URL of the OTLP collector (e.g. `http://localhost:4318`). Takes precedence over `OTEL_EXPORTER_OTLP_ENDPOINT`.",
+						Optional:            true,
+					},
+					"protocol": schema.StringAttribute{
+						MarkdownDescription: "OTLP protocol, either `grpc` or `http/protobuf`. Takes precedence over `OTEL_EXPORTER_OTLP_PROTOCOL`, default to `http/protobuf`.",
+						Optional:            true,
+						Validators: []validator.String{
+							validators.NewStringEnumValidator([]basetypes.StringValue{
+								types.StringValue(utils.ProtocolGRPC),
+								types.StringValue(utils.ProtocolHTTP),
+							}),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -129,7 +247,7 @@ func (p *CTFdProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 			"The provider cannot create the CTFd API client as there is an unknown username.",
 		)
 	}
-	if config.APIKey.IsUnknown() {
+	if config.Password.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("password"),
 			"Unknown CTFd admin or service account password.",
@@ -137,10 +255,93 @@ func (p *CTFdProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		)
 	}
 
+	if config.CACertFile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ca_cert_file"),
+			"Unknown CA certificate file.",
+			"The provider cannot create the CTFd API client as there is an unknown CA certificate file.",
+		)
+	}
+	if config.CACertPEM.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ca_cert_pem"),
+			"Unknown CA certificate.",
+			"The provider cannot create the CTFd API client as there is an unknown CA certificate.",
+		)
+	}
+	if config.ClientCert.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_cert"),
+			"Unknown client certificate.",
+			"The provider cannot create the CTFd API client as there is an unknown client certificate.",
+		)
+	}
+	if config.ClientKey.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_key"),
+			"Unknown client key.",
+			"The provider cannot create the CTFd API client as there is an unknown client key.",
+		)
+	}
+	if config.InsecureSkipVerify.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("insecure_skip_verify"),
+			"Unknown TLS verification setting.",
+			"The provider cannot create the CTFd API client as there is an unknown TLS verification setting.",
+		)
+	}
+	if config.ProxyURL.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("proxy_url"),
+			"Unknown proxy URL.",
+			"The provider cannot create the CTFd API client as there is an unknown proxy URL.",
+		)
+	}
+	for k, v := range config.Headers {
+		if v.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("headers").AtMapKey(k),
+				"Unknown header value.",
+				fmt.Sprintf("The provider cannot create the CTFd API client as there is an unknown value for header %s.", k),
+			)
+		}
+	}
+	if config.BasicAuth != nil && (config.BasicAuth.Username.IsUnknown() || config.BasicAuth.Password.IsUnknown()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("basic_auth"),
+			"Unknown basic auth credentials.",
+			"The provider cannot create the CTFd API client as there are unknown basic auth credentials.",
+		)
+	}
+
+	if config.Telemetry != nil && (config.Telemetry.Endpoint.IsUnknown() || config.Telemetry.Protocol.IsUnknown()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("telemetry"),
+			"Unknown telemetry settings.",
+			"The provider cannot export its telemetry as there are unknown telemetry settings.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Export telemetry first, to trace the login too
+	telConf := utils.TelemetryConfig{
+		Version: p.version,
+	}
+	if config.Telemetry != nil {
+		telConf.Endpoint = config.Telemetry.Endpoint.ValueString()
+		telConf.Protocol = config.Telemetry.Protocol.ValueString()
+	}
+	if err := utils.SetupTelemetry(ctx, telConf); err != nil {
+		resp.Diagnostics.AddError(
+			"CTFd provider configuration error",
+			fmt.Sprintf("Failed to configure the telemetry export: %s", err),
+		)
+		return
+	}
+
 	// Extract environment variables values
 	url := os.Getenv("CTFD_URL")
 	apiKey := os.Getenv("CTFD_API_KEY")
@@ -160,6 +361,139 @@ func (p *CTFdProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		password = config.Password.ValueString()
 	}
 
+	tpConf := utils.TransportConfig{
+		CACertFile: os.Getenv("CTFD_CA_CERT_FILE"),
+		CACertPEM:  os.Getenv("CTFD_CA_CERT_PEM"),
+		ClientCert: os.Getenv("CTFD_CLIENT_CERT"),
+		ClientKey:  os.Getenv("CTFD_CLIENT_KEY"),
+		ProxyURL:   os.Getenv("CTFD_PROXY_URL"),
+	}
+	if v, ok := os.LookupEnv("CTFD_INSECURE_SKIP_VERIFY"); ok {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"CTFd provider configuration error",
+				fmt.Sprintf("Invalid CTFD_INSECURE_SKIP_VERIFY environment variable: %s", err),
+			)
+			return
+		}
+		tpConf.InsecureSkipVerify = b
+	}
+	if !config.CACertFile.IsNull() {
+		tpConf.CACertFile = config.CACertFile.ValueString()
+	}
+	if !config.CACertPEM.IsNull() {
+		tpConf.CACertPEM = config.CACertPEM.ValueString()
+	}
+	if !config.ClientCert.IsNull() {
+		tpConf.ClientCert = config.ClientCert.ValueString()
+	}
+	if !config.ClientKey.IsNull() {
+		tpConf.ClientKey = config.ClientKey.ValueString()
+	}
+	if !config.InsecureSkipVerify.IsNull() {
+		tpConf.InsecureSkipVerify = config.InsecureSkipVerify.ValueBool()
+	}
+	if !config.ProxyURL.IsNull() {
+		tpConf.ProxyURL = config.ProxyURL.ValueString()
+	}
+
+	headers := map[string]string{}
+	for k, v := range config.Headers {
+		headers[k] = v.ValueString()
+	}
+	basicUser, basicPass := "", ""
+	if config.BasicAuth != nil {
+		basicUser = config.BasicAuth.Username.ValueString()
+		basicPass = config.BasicAuth.Password.ValueString()
+		if basicUser == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("basic_auth").AtName("username"),
+				"Missing basic auth username.",
+				"The basic_auth block requires a username.",
+			)
+			return
+		}
+		if apiKey != "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("basic_auth"),
+				"Conflicting basic auth and API key.",
+				"The basic auth credentials and the CTFd API key are both sent in the Authorization header, use a username and password to login instead.",
+			)
+			return
+		}
+	}
+
+	// Build the transport shared by all calls
+	maxRetries := 3
+	minWait := time.Second
+	maxWait := 30 * time.Second
+	if !config.MaxRetries.IsNull() {
+		maxRetries = int(config.MaxRetries.ValueInt64())
+		if maxRetries < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_retries"),
+				"Invalid max_retries.",
+				"The maximum number of retries must be positive or zero.",
+			)
+		}
+	}
+	if !config.RetryMinWait.IsNull() {
+		d, err := time.ParseDuration(config.RetryMinWait.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_min_wait"),
+				"Invalid retry_min_wait.",
+				fmt.Sprintf("The minimum retry wait is not a valid duration: %s", err),
+			)
+		}
+		minWait = d
+	}
+	if !config.RetryMaxWait.IsNull() {
+		d, err := time.ParseDuration(config.RetryMaxWait.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_max_wait"),
+				"Invalid retry_max_wait.",
+				fmt.Sprintf("The maximum retry wait is not a valid duration: %s", err),
+			)
+		}
+		maxWait = d
+	}
+	if minWait > maxWait {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_min_wait"),
+			"Invalid retry_min_wait.",
+			"The minimum retry wait must not exceed the maximum one.",
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	base, err := utils.NewTransport(tpConf)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"CTFd provider configuration error",
+			fmt.Sprintf("Failed to configure the HTTP transport: %s", err),
+		)
+		return
+	}
+	// Surface CTFd 404 as a typed error, to detect objects
+	// deleted outside of Terraform
+	transport := &utils.NotFoundTransport{
+		Base: &utils.RetryTransport{
+			Base: otelhttp.NewTransport(&utils.HeaderTransport{
+				Base:     base,
+				Headers:  headers,
+				Username: basicUser,
+				Password: basicPass,
+			}),
+			MaxRetries: maxRetries,
+			MinWait:    minWait,
+			MaxWait:    maxWait,
+		},
+	}
+
 	// Check there is enough content
 	ak := apiKey != ""
 	up := username != "" && password != ""
@@ -176,9 +510,13 @@ func (p *CTFdProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 	ctx = utils.AddSensitive(ctx, "ctfd_api_key", apiKey)
 	ctx = utils.AddSensitive(ctx, "ctfd_username", username)
 	ctx = utils.AddSensitive(ctx, "ctfd_password", password)
+	ctx = utils.AddSensitive(ctx, "ctfd_client_key", tpConf.ClientKey)
+	ctx = utils.AddSensitive(ctx, "ctfd_headers", headers)
+	ctx = utils.AddSensitive(ctx, "ctfd_basic_auth_username", basicUser)
+	ctx = utils.AddSensitive(ctx, "ctfd_basic_auth_password", basicPass)
 	tflog.Debug(ctx, "Creating CTFd API client")
 
-	nonce, session, err := api.GetNonceAndSession(url, api.WithContext(ctx), api.WithTransport(otelhttp.NewTransport(nil)))
+	nonce, session, err := utils.GetNonceAndSession(ctx, transport, url)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"CTFd error",
@@ -187,17 +525,9 @@ func (p *CTFdProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		return
 	}
 
-	client := api.NewClient(url, nonce, session, apiKey)
 	if up {
-		// XXX due to the CTFd ratelimiter on rare endpoint
-		if _, ok := os.LookupEnv("TF_ACC"); ok {
-			time.Sleep(5 * time.Second)
-		}
-
-		if err := client.Login(&api.LoginParams{
-			Name:     username,
-			Password: password,
-		}, api.WithContext(ctx), api.WithTransport(otelhttp.NewTransport(nil))); err != nil {
+		nonce, session, err = utils.Login(ctx, transport, url, nonce, session, username, password)
+		if err != nil {
 			resp.Diagnostics.AddError(
 				"CTFd error",
 				fmt.Sprintf("Failed to login: %s", err),
@@ -205,6 +535,7 @@ func (p *CTFdProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 			return
 		}
 	}
+	client := utils.NewClient(api.NewClient(url, nonce, session, apiKey), transport)
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
@@ -217,17 +548,29 @@ func (p *CTFdProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 
 func (p *CTFdProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
-		challenge.NewChallengeDynamicResource,
-		challenge.NewChallengeStandardResource,
+		award.NewAwardResource,
+		bracket.NewBracketResource,
+		challenge.NewChallengeResource,
+		config.NewConfigResource,
+		flag.NewFlagResource,
+		hint.NewHintResource,
+		notification.NewNotificationResource,
+		page.NewPageResource,
 		solution.NewSolutionResource,
 		team.NewTeamResource,
+		token.NewTokenResource,
 		user.NewUserResource,
 	}
 }
 
 func (p *CTFdProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		bracket.NewBracketDataSource,
+		challenge.NewChallengeDataSource,
+		challenge.NewChallengesDataSource,
 		user.NewUserDataSource,
+		user.NewSingleUserDataSource,
 		team.NewTeamDataSource,
+		team.NewSingleTeamDataSource,
 	}
 }
@@ -0,0 +1,304 @@
+package award
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/ctfer-io/go-ctfd/api"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/utils"
+)
+
+var (
+	_ resource.Resource                   = (*awardResource)(nil)
+	_ resource.ResourceWithConfigure      = (*awardResource)(nil)
+	_ resource.ResourceWithImportState    = (*awardResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*awardResource)(nil)
+)
+
+func NewAwardResource() resource.Resource {
+	return &awardResource{}
+}
+
+type awardResource struct {
+	client *utils.Client
+}
+
+type awardResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	UserID      types.String `tfsdk:"user_id"`
+	TeamID      types.String `tfsdk:"team_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Value       types.Int64  `tfsdk:"value"`
+	Category    types.String `tfsdk:"category"`
+	Icon        types.String `tfsdk:"icon"`
+}
+
+func (r *awardResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_award"
+}
+
+func (r *awardResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "An award grants bonus points (or a penalty, if negative) to a user or a team. CTFd does not support updating awards, so any change recreates it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the award, used internally to handle the CTFd corresponding object.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "User to give the award to. In teams mode, the award goes to its team too. Exactly one of `user_id` or `team_id` must be set.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Team to give the award to, only relevant in teams mode. Exactly one of `user_id` or `team_id` must be set.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the award, displayed in the scoreboard.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the award, e.g. why it was granted.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.Int64Attribute{
+				MarkdownDescription: "Points of the award. A negative value is a penalty.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"category": schema.StringAttribute{
+				MarkdownDescription: "Category of the award.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"icon": schema.StringAttribute{
+				MarkdownDescription: "Icon of the award (e.g. `shield`, `bug`, `crown`, `crosshairs`, `ban`, `lightning`, `skull`, `brain`, `code`, `cowboy`, `angry`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *awardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data awardResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values may be unknown until apply (e.g. ctfd_user.x.id)
+	if data.UserID.IsUnknown() || data.TeamID.IsUnknown() {
+		return
+	}
+	if data.UserID.IsNull() == data.TeamID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("user_id"),
+			"Invalid Attribute Combination",
+			"Exactly one of user_id or team_id must be set.",
+		)
+	}
+}
+
+func (r *awardResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*utils.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *github.com/AlexEreh/terraform-provider-ctfd/provider/utils.Client, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *awardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_award", "Create")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	var data awardResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &postAwardsParams{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		Category:    data.Category.ValueString(),
+		Icon:        data.Icon.ValueString(),
+		Value:       int(data.Value.ValueInt64()),
+	}
+	if !data.UserID.IsNull() {
+		params.UserID = utils.Ptr(utils.Atoi(data.UserID.ValueString()))
+	}
+	if !data.TeamID.IsNull() {
+		params.TeamID = utils.Ptr(utils.Atoi(data.TeamID.ValueString()))
+	}
+	res := &api.Award{}
+	if err := r.client.Post("/awards", params, res, r.client.Options(ctx)...); err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create award, got error: %s", err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "created an award")
+
+	// Save computed attributes in state
+	data.ID = types.StringValue(strconv.Itoa(res.ID))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *awardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_award", "Read")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	var data awardResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.GetAward(data.ID.ValueString(), r.client.Options(ctx)...)
+	if err != nil {
+		if utils.IsNotFound(err) {
+			// Deleted outside of Terraform, plan its re-creation
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read award %s, got error: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	// In teams mode, CTFd fills both the user and the team of the award.
+	// Only consider the one configured, or guess on import.
+	switch {
+	case !data.UserID.IsNull():
+		data.UserID = types.StringValue(strconv.Itoa(res.UserID))
+	case !data.TeamID.IsNull():
+		data.TeamID = types.StringValue(strconv.Itoa(res.TeamID))
+	case res.UserID != 0:
+		data.UserID = types.StringValue(strconv.Itoa(res.UserID))
+	default:
+		data.TeamID = types.StringValue(strconv.Itoa(res.TeamID))
+	}
+	data.Name = types.StringValue(res.Name)
+	data.Description = types.StringValue("")
+	if res.Description != nil {
+		data.Description = types.StringValue(*res.Description)
+	}
+	data.Value = types.Int64Value(int64(res.Value))
+	data.Category = types.StringValue("")
+	if res.Category != nil {
+		data.Category = types.StringValue(*res.Category)
+	}
+	data.Icon = types.StringValue(res.Icon)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *awardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_award", "Update")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	// All attributes require replacement, as CTFd does not support
+	// updating an award.
+	var data awardResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *awardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_award", "Delete")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	var data awardResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteAward(data.ID.ValueString(), r.client.Options(ctx)...); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete award %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+}
+
+func (r *awardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	// Automatically call r.Read
+}
+
+// postAwardsParams is a replacement of api.PostAwardsParams, as the
+// latter can't give an award to a team without one of its users.
+type postAwardsParams struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Category    string `json:"category"`
+	Icon        string `json:"icon"`
+	UserID      *int   `json:"user_id,omitempty"`
+	TeamID      *int   `json:"team_id,omitempty"`
+	Value       int    `json:"value"`
+}
@@ -0,0 +1,134 @@
+package bracket
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/ctfer-io/go-ctfd/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/utils"
+)
+
+var (
+	_ datasource.DataSource              = (*bracketDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*bracketDataSource)(nil)
+)
+
+func NewBracketDataSource() datasource.DataSource {
+	return &bracketDataSource{}
+}
+
+type bracketDataSource struct {
+	client *utils.Client
+}
+
+type bracketsDataSourceModel struct {
+	ID       types.String           `tfsdk:"id"`
+	Name     types.String           `tfsdk:"name"`
+	Type     types.String           `tfsdk:"type"`
+	Brackets []bracketResourceModel `tfsdk:"brackets"`
+}
+
+func (bk *bracketDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_brackets"
+}
+
+func (bk *bracketDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the brackets of the CTFd instance, eventually filtered.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Only list the bracket with this name.",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only list the brackets of this type (`users` or `teams`).",
+				Optional:            true,
+			},
+			"brackets": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the bracket.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the bracket.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the bracket.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the bracket, either `users` or `teams`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (bk *bracketDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*utils.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *github.com/AlexEreh/terraform-provider-ctfd/provider/utils.Client, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", req.ProviderData),
+		)
+		return
+	}
+
+	bk.client = client
+}
+
+func (bk *bracketDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_brackets", "Read")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	var state bracketsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	brackets, err := bk.client.GetBrackets(&api.GetBracketsParams{
+		Name: state.Name.ValueStringPointer(),
+		Type: state.Type.ValueStringPointer(),
+	}, bk.client.Options(ctx)...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read CTFd Brackets",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Brackets = make([]bracketResourceModel, 0, len(brackets))
+	for _, b := range brackets {
+		state.Brackets = append(state.Brackets, bracketResourceModel{
+			ID:          types.StringValue(strconv.Itoa(b.ID)),
+			Name:        types.StringValue(b.Name),
+			Description: types.StringValue(b.Description),
+			Type:        types.StringValue(b.Type),
+		})
+	}
+
+	state.ID = types.StringValue("placeholder")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,226 @@
+package bracket
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/ctfer-io/go-ctfd/api"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/utils"
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/validators"
+)
+
+var (
+	_ resource.Resource                = (*bracketResource)(nil)
+	_ resource.ResourceWithConfigure   = (*bracketResource)(nil)
+	_ resource.ResourceWithImportState = (*bracketResource)(nil)
+)
+
+func NewBracketResource() resource.Resource {
+	return &bracketResource{}
+}
+
+type bracketResource struct {
+	client *utils.Client
+}
+
+type bracketResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Type        types.String `tfsdk:"type"`
+}
+
+func (r *bracketResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bracket"
+}
+
+func (r *bracketResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A bracket to rank users or teams in separate scoreboards (e.g. students and professionals).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the bracket, used internally to handle the CTFd corresponding object.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the bracket.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the bracket.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the bracket, either `users` or `teams`. Should match the CTF user mode.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.NewStringEnumValidator([]basetypes.StringValue{
+						types.StringValue("users"),
+						types.StringValue("teams"),
+					}),
+				},
+			},
+		},
+	}
+}
+
+func (r *bracketResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*utils.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *github.com/AlexEreh/terraform-provider-ctfd/provider/utils.Client, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *bracketResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_bracket", "Create")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	var data bracketResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.PostBrackets(&api.PostBracketsParams{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		Type:        data.Type.ValueString(),
+	}, r.client.Options(ctx)...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create bracket, got error: %s", err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "created a bracket")
+
+	// Save computed attributes in state
+	data.ID = types.StringValue(strconv.Itoa(res.ID))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *bracketResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_bracket", "Read")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	var data bracketResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// CTFd does not expose a single bracket endpoint, so look for it in the list
+	brackets, err := r.client.GetBrackets(nil, r.client.Options(ctx)...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read bracket %s, got error: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+	var res *api.Bracket
+	for _, bk := range brackets {
+		if strconv.Itoa(bk.ID) == data.ID.ValueString() {
+			res = bk
+			break
+		}
+	}
+	if res == nil {
+		// Deleted outside of Terraform, plan its re-creation
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Name = types.StringValue(res.Name)
+	data.Description = types.StringValue(res.Description)
+	data.Type = types.StringValue(res.Type)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *bracketResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_bracket", "Update")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	var data bracketResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.client.PatchBrackets(utils.Atoi(data.ID.ValueString()), &api.PatchBracketsParams{
+		Name:        data.Name.ValueStringPointer(),
+		Description: data.Description.ValueStringPointer(),
+		Type:        data.Type.ValueStringPointer(),
+	}, r.client.Options(ctx)...); err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to update bracket %s, got error: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *bracketResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_bracket", "Delete")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	var data bracketResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteBrackets(utils.Atoi(data.ID.ValueString()), r.client.Options(ctx)...); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete bracket %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+}
+
+func (r *bracketResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	// Automatically call r.Read
+}
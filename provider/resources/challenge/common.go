@@ -1,12 +1,20 @@
 package challenge
 
 import (
+	"github.com/ctfer-io/go-ctfd/api"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/AlexEreh/terraform-provider-ctfd/provider/utils"
 )
 
 var (
+	TypeStandard = types.StringValue("standard")
+	TypeDynamic  = types.StringValue("dynamic")
+
+	LogicAny  = types.StringValue("any")
+	LogicAll  = types.StringValue("all")
+	LogicTeam = types.StringValue("team")
+
 	BehaviorHidden     = types.StringValue("hidden")
 	BehaviorAnonymized = types.StringValue("anonymized")
 
@@ -14,43 +22,74 @@ var (
 	FunctionLogarithmic = types.StringValue("logarithmic")
 
 	FlagCaseInsensitive = types.StringValue("case_insensitive")
+	FlagCaseSensitive   = types.StringValue("case_sensitive")
 
 	FlagTypeStatic       = types.StringValue("static")
 	FlagTypeRegex        = types.StringValue("regex")
 	FlagTypeProgrammable = types.StringValue("programmable")
 
-	// File types/locations for CTFd Files API
+	// File types for CTFd Files API
 	FileTypeChallenge = types.StringValue("challenge")
-
-	FileLocationChallenge = types.StringValue("challenge")
 )
 
 type RequirementsSubresourceModel struct {
-	Behavior      types.String   `tfsdk:"behavior"`
-	Prerequisites []types.String `tfsdk:"prerequisites"`
+	Behavior      types.String  `tfsdk:"behavior"`
+	Prerequisites []types.Int64 `tfsdk:"prerequisites"`
 }
 
+// FlagSubresourceModel describes a single flag of a challenge.
+// It is tracked by its CTFd identifier such that flags edited through
+// the web UI are detected and reconciled on the next apply.
+// For static and regex flags, "case" is stored by CTFd as their data.
 type FlagSubresourceModel struct {
-	Type types.String `tfsdk:"type"`
-	Case types.String `tfsdk:"case"`
-	Flag types.String `tfsdk:"flag"`
+	ID         types.Int64    `tfsdk:"id"`
+	Type       types.String   `tfsdk:"type"`
+	Content    types.String   `tfsdk:"content"`
+	Case       types.String   `tfsdk:"case"`
+	Data       types.String   `tfsdk:"data"`
+	TestInputs []types.String `tfsdk:"test_inputs"`
 }
 
-// FileSubresourceModel describes a single file attached to a challenge.
-// "path" is a local filesystem path used only for upload (write-only, ForceNew
-// in the schema); CTFd API does not let us read file content back, only
-// metadata such as id/name/location.
+// FileSubresourceModel describes a single file attached to a challenge,
+// with the challenge-specific attributes.
 type FileSubresourceModel struct {
-	ID         types.Int64  `tfsdk:"id"`
-	Name       types.String `tfsdk:"name"`
-	Path       types.String `tfsdk:"path"`
-	Type       types.String `tfsdk:"type"`
-	Location   types.String `tfsdk:"location"`
+	utils.FileSubresourceModel
 	Challenge  types.Int64  `tfsdk:"challenge_id"`
-	URL        types.String `tfsdk:"url"`
 	AccessType types.String `tfsdk:"access_type"`
 }
 
+// ToAPIRequirements returns the CTFd requirements of a challenge, or
+// nil if it has none.
+func ToAPIRequirements(reqs *RequirementsSubresourceModel) *api.Requirements {
+	if reqs == nil {
+		return nil
+	}
+	preqs := make([]int, 0, len(reqs.Prerequisites))
+	for _, preq := range reqs.Prerequisites {
+		preqs = append(preqs, int(preq.ValueInt64()))
+	}
+	return &api.Requirements{
+		Anonymize:     GetAnon(reqs.Behavior),
+		Prerequisites: preqs,
+	}
+}
+
+// FromAPIRequirements returns the requirements of a challenge from
+// CTFd ones, or nil if it has none.
+func FromAPIRequirements(reqs *api.Requirements) *RequirementsSubresourceModel {
+	if reqs == nil {
+		return nil
+	}
+	preqs := make([]types.Int64, 0, len(reqs.Prerequisites))
+	for _, preq := range reqs.Prerequisites {
+		preqs = append(preqs, types.Int64Value(int64(preq)))
+	}
+	return &RequirementsSubresourceModel{
+		Behavior:      FromAnon(reqs.Anonymize),
+		Prerequisites: preqs,
+	}
+}
+
 func GetAnon(str types.String) *bool {
 	switch {
 	case str.Equal(BehaviorHidden):
@@ -0,0 +1,345 @@
+package challenge
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/ctfer-io/go-ctfd/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/utils"
+)
+
+var (
+	_ datasource.DataSource              = (*challengesDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*challengesDataSource)(nil)
+)
+
+func NewChallengesDataSource() datasource.DataSource {
+	return &challengesDataSource{}
+}
+
+type challengesDataSource struct {
+	client *utils.Client
+}
+
+type challengesDataSourceModel struct {
+	ID         types.String               `tfsdk:"id"`
+	Category   types.String               `tfsdk:"category"`
+	Type       types.String               `tfsdk:"type"`
+	State      types.String               `tfsdk:"state"`
+	NameRegex  types.String               `tfsdk:"name_regex"`
+	Challenges []challengeDataSourceModel `tfsdk:"challenges"`
+}
+
+// challengeDataSourceModel flattens all the challenge types
+// attributes, such that they could be listed together.
+type challengeDataSourceModel struct {
+	ID             types.String                  `tfsdk:"id"`
+	Name           types.String                  `tfsdk:"name"`
+	Category       types.String                  `tfsdk:"category"`
+	Description    types.String                  `tfsdk:"description"`
+	Attribution    types.String                  `tfsdk:"attribution"`
+	ConnectionInfo types.String                  `tfsdk:"connection_info"`
+	MaxAttempts    types.Int64                   `tfsdk:"max_attempts"`
+	Value          types.Int64                   `tfsdk:"value"`
+	Decay          types.Int64                   `tfsdk:"decay"`
+	Minimum        types.Int64                   `tfsdk:"minimum"`
+	Function       types.String                  `tfsdk:"function"`
+	Type           types.String                  `tfsdk:"type"`
+	Logic          types.String                  `tfsdk:"logic"`
+	State          types.String                  `tfsdk:"state"`
+	Next           types.Int64                   `tfsdk:"next"`
+	Requirements   *RequirementsSubresourceModel `tfsdk:"requirements"`
+	Tags           []types.String                `tfsdk:"tags"`
+	Topics         []types.String                `tfsdk:"topics"`
+}
+
+func (ch *challengesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_challenges"
+}
+
+func (ch *challengesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the challenges of the CTFd instance, whatever their type, eventually filtered.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"category": schema.StringAttribute{
+				MarkdownDescription: "Only list the challenges of this category.",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only list the challenges of this type (e.g. standard, dynamic).",
+				Optional:            true,
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "Only list the challenges in this state, either hidden or visible.",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only list the challenges which name matches this regular expression (RE2 syntax).",
+				Optional:            true,
+			},
+			"challenges": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: challengeDataSourceAttributes(false),
+				},
+			},
+		},
+	}
+}
+
+func (ch *challengesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*utils.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *github.com/AlexEreh/terraform-provider-ctfd/provider/utils.Client, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", req.ProviderData),
+		)
+		return
+	}
+
+	ch.client = client
+}
+
+func (ch *challengesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_challenges", "Read")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	var state challengesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		re, err := regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Regular Expression",
+				fmt.Sprintf("Unable to compile name_regex, got error: %s", err),
+			)
+			return
+		}
+		nameRegex = re
+	}
+
+	// CTFd challenges list does not support filtering by category name,
+	// so this filter is applied afterward.
+	challs, err := ch.client.GetChallenges(&api.GetChallengesParams{
+		Type:  state.Type.ValueStringPointer(),
+		State: state.State.ValueStringPointer(),
+		View:  utils.Ptr("admin"),
+	}, ch.client.Options(ctx)...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read CTFd Challenges",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Challenges = make([]challengeDataSourceModel, 0, len(challs))
+	for _, c := range challs {
+		if !state.Category.IsNull() && c.Category != state.Category.ValueString() {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(c.Name) {
+			continue
+		}
+
+		chall, diags := readChallengeDataSource(ctx, ch.client, c.ID)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Challenges = append(state.Challenges, chall)
+	}
+
+	state.ID = types.StringValue("placeholder")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// readChallengeDataSource reads a challenge and its subresources, whatever its type.
+func readChallengeDataSource(ctx context.Context, client *utils.Client, id int) (challengeDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	chall := challengeDataSourceModel{}
+
+	res, err := client.GetChallenge(id, client.Options(ctx)...)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read challenge %d, got error: %s", id, err))
+		return chall, diags
+	}
+	chall.ID = types.StringValue(strconv.Itoa(res.ID))
+	chall.Name = types.StringValue(res.Name)
+	chall.Category = types.StringValue(res.Category)
+	chall.Description = types.StringValue(res.Description)
+	chall.Attribution = types.StringPointerValue(res.Attribution)
+	chall.ConnectionInfo = utils.ToTFString(res.ConnectionInfo)
+	chall.MaxAttempts = utils.ToTFInt64(res.MaxAttempts)
+	chall.Value = types.Int64Value(int64(res.Value))
+	if res.Type == "dynamic" {
+		// Same as the ctfd_challenge resource, value is mapped to initial
+		chall.Value = utils.ToTFInt64(res.Initial)
+	}
+	chall.Decay = utils.ToTFInt64(res.Decay)
+	chall.Minimum = utils.ToTFInt64(res.Minimum)
+	chall.Function = utils.ToTFString(res.Function)
+	chall.Type = types.StringValue(res.Type)
+	chall.Logic = types.StringValue(res.Logic)
+	chall.State = types.StringValue(res.State)
+	chall.Next = utils.ToTFInt64(res.NextID)
+
+	// => Requirements
+	resReqs, err := client.GetChallengeRequirements(id, client.Options(ctx)...)
+	if err != nil {
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read challenge %d requirements, got error: %s", id, err),
+		)
+		return chall, diags
+	}
+	chall.Requirements = FromAPIRequirements(resReqs)
+
+	// => Tags
+	resTags, err := client.GetChallengeTags(id, client.Options(ctx)...)
+	if err != nil {
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read challenge %d tags, got error: %s", id, err),
+		)
+		return chall, diags
+	}
+	chall.Tags = make([]types.String, 0, len(resTags))
+	for _, tag := range resTags {
+		chall.Tags = append(chall.Tags, types.StringValue(tag.Value))
+	}
+
+	// => Topics
+	resTopics, err := client.GetChallengeTopics(id, client.Options(ctx)...)
+	if err != nil {
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read challenge %d topics, got error: %s", id, err),
+		)
+		return chall, diags
+	}
+	chall.Topics = make([]types.String, 0, len(resTopics))
+	for _, topic := range resTopics {
+		chall.Topics = append(chall.Topics, types.StringValue(topic.Value))
+	}
+
+	return chall, diags
+}
+
+// challengeDataSourceAttributes returns the attributes of a challenge
+// as read by a data source. If lookup is true, the attributes that are
+// used to look for a single challenge are also configurable.
+func challengeDataSourceAttributes(lookup bool) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Identifier of the challenge.",
+			Optional:            lookup,
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the challenge, displayed as it.",
+			Optional:            lookup,
+			Computed:            true,
+		},
+		"category": schema.StringAttribute{
+			MarkdownDescription: "Category of the challenge that CTFd groups by on the web UI.",
+			Optional:            lookup,
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Description of the challenge, consider using multiline descriptions for better style.",
+			Computed:            true,
+		},
+		"attribution": schema.StringAttribute{
+			MarkdownDescription: "Attribution to the creator(s) of the challenge.",
+			Computed:            true,
+		},
+		"connection_info": schema.StringAttribute{
+			MarkdownDescription: "Connection Information to connect to the challenge instance, useful for pwn or web pentest.",
+			Computed:            true,
+		},
+		"max_attempts": schema.Int64Attribute{
+			MarkdownDescription: "Maximum amount of attempts before being unable to flag the challenge.",
+			Computed:            true,
+		},
+		"value": schema.Int64Attribute{
+			MarkdownDescription: "The value (points) of the challenge once solved. For dynamic challenges, it is mapped to `initial` under the hood.",
+			Computed:            true,
+		},
+		"decay": schema.Int64Attribute{
+			MarkdownDescription: "The decay defines from each number of solves does the decay function triggers until reaching minimum. Only set for dynamic challenges.",
+			Computed:            true,
+		},
+		"minimum": schema.Int64Attribute{
+			MarkdownDescription: "The minimum points for a dynamic-score challenge to reach with the decay function. Only set for dynamic challenges.",
+			Computed:            true,
+		},
+		"function": schema.StringAttribute{
+			MarkdownDescription: "Decay function to define how the challenge value evolve through solves, either linear or logarithmic. Only set for dynamic challenges.",
+			Computed:            true,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "Type of the challenge (e.g. standard, dynamic).",
+			Computed:            true,
+		},
+		"logic": schema.StringAttribute{
+			MarkdownDescription: "The flag validation logic.",
+			Computed:            true,
+		},
+		"state": schema.StringAttribute{
+			MarkdownDescription: "State of the challenge, either hidden or visible.",
+			Computed:            true,
+		},
+		"next": schema.Int64Attribute{
+			MarkdownDescription: "Suggestion for the end-user as next challenge to work on.",
+			Computed:            true,
+		},
+		"requirements": schema.SingleNestedAttribute{
+			MarkdownDescription: "List of required challenges that needs to get flagged before this one being accessible. Useful for skill-trees-like strategy CTF.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"behavior": schema.StringAttribute{
+					MarkdownDescription: "Behavior if not unlocked, either hidden or anonymized.",
+					Computed:            true,
+				},
+				"prerequisites": schema.ListAttribute{
+					MarkdownDescription: "List of the challenges ID.",
+					Computed:            true,
+					ElementType:         types.Int64Type,
+				},
+			},
+		},
+		"tags": schema.ListAttribute{
+			MarkdownDescription: "List of challenge tags that will be displayed to the end-user. You could use them to give some quick insights of what a challenge involves.",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+		"topics": schema.ListAttribute{
+			MarkdownDescription: "List of challenge topics that are displayed to the administrators for maintenance and planification.",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+	}
+}
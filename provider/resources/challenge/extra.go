@@ -0,0 +1,53 @@
+package challenge
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// parseExtra decodes the extra attributes of a challenge, as a JSON
+// object. A null or unknown extra returns no attributes.
+func parseExtra(extra types.String) (map[string]any, error) {
+	if extra.IsNull() || extra.IsUnknown() {
+		return nil, nil
+	}
+	attrs := map[string]any{}
+	if err := json.Unmarshal([]byte(extra.ValueString()), &attrs); err != nil {
+		return nil, fmt.Errorf("extra must be a JSON object: %w", err)
+	}
+	return attrs, nil
+}
+
+// readExtra refreshes the extra attributes of prior from the ones
+// returned by CTFd. Only the keys of prior are read back, as CTFd
+// returns many more attributes than the configured ones.
+// If they are semantically unchanged, prior is returned as is such that
+// the JSON formatting does not produce a diff.
+func readExtra(prior types.String, attrs map[string]any) (types.String, error) {
+	priorAttrs, err := parseExtra(prior)
+	if err != nil || priorAttrs == nil {
+		return prior, err
+	}
+
+	current := make(map[string]any, len(priorAttrs))
+	for k, v := range priorAttrs {
+		if rv, ok := attrs[k]; ok {
+			current[k] = rv
+			continue
+		}
+		// Not returned by CTFd (e.g. write-only), keep it
+		current[k] = v
+	}
+	if reflect.DeepEqual(current, priorAttrs) {
+		return prior, nil
+	}
+
+	b, err := json.Marshal(current)
+	if err != nil {
+		return prior, err
+	}
+	return types.StringValue(string(b)), nil
+}
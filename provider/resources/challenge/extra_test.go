@@ -0,0 +1,112 @@
+package challenge
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func Test_U_ParseExtra(t *testing.T) {
+	var tests = map[string]struct {
+		Extra       types.String
+		ExpectAttrs int
+		ExpectErr   bool
+	}{
+		"null": {
+			Extra: types.StringNull(),
+		},
+		"unknown": {
+			Extra: types.StringUnknown(),
+		},
+		"object": {
+			Extra:       types.StringValue(`{"image": "nginx", "port": 80}`),
+			ExpectAttrs: 2,
+		},
+		"array": {
+			Extra:     types.StringValue(`["image"]`),
+			ExpectErr: true,
+		},
+		"invalid": {
+			Extra:     types.StringValue(`{"image": }`),
+			ExpectErr: true,
+		},
+	}
+
+	for testname, tt := range tests {
+		t.Run(testname, func(t *testing.T) {
+			attrs, err := parseExtra(tt.Extra)
+
+			if (err != nil) != tt.ExpectErr {
+				t.Fatalf("expected error: %t, got %v", tt.ExpectErr, err)
+			}
+			if len(attrs) != tt.ExpectAttrs {
+				t.Fatalf("expected %d attributes, got %d", tt.ExpectAttrs, len(attrs))
+			}
+		})
+	}
+}
+
+func Test_U_ReadExtra(t *testing.T) {
+	var tests = map[string]struct {
+		Prior     types.String
+		Attrs     map[string]any
+		Expect    types.String
+		ExpectErr bool
+	}{
+		"null": {
+			Prior:  types.StringNull(),
+			Attrs:  map[string]any{"image": "nginx"},
+			Expect: types.StringNull(),
+		},
+		"unchanged-keeps-formatting": {
+			Prior: types.StringValue("{\n  \"port\": 80,\n  \"image\": \"nginx\"\n}"),
+			Attrs: map[string]any{
+				"image": "nginx",
+				"port":  float64(80),
+				// Not configured, thus not read back
+				"name":  "chall",
+				"value": float64(500),
+			},
+			Expect: types.StringValue("{\n  \"port\": 80,\n  \"image\": \"nginx\"\n}"),
+		},
+		"changed": {
+			Prior: types.StringValue(`{"image": "nginx", "port": 80}`),
+			Attrs: map[string]any{
+				"image": "nginx:alpine",
+				"port":  float64(80),
+			},
+			Expect: types.StringValue(`{"image":"nginx:alpine","port":80}`),
+		},
+		"nested": {
+			Prior: types.StringValue(`{"limits": {"cpu": "1"}}`),
+			Attrs: map[string]any{
+				"limits": map[string]any{"cpu": "2"},
+			},
+			Expect: types.StringValue(`{"limits":{"cpu":"2"}}`),
+		},
+		"write-only-kept": {
+			Prior:  types.StringValue(`{"secret": "s3cr3t"}`),
+			Attrs:  map[string]any{"image": "nginx"},
+			Expect: types.StringValue(`{"secret": "s3cr3t"}`),
+		},
+		"invalid-prior": {
+			Prior:     types.StringValue(`not json`),
+			Attrs:     map[string]any{},
+			Expect:    types.StringValue(`not json`),
+			ExpectErr: true,
+		},
+	}
+
+	for testname, tt := range tests {
+		t.Run(testname, func(t *testing.T) {
+			extra, err := readExtra(tt.Prior, tt.Attrs)
+
+			if (err != nil) != tt.ExpectErr {
+				t.Fatalf("expected error: %t, got %v", tt.ExpectErr, err)
+			}
+			if !extra.Equal(tt.Expect) {
+				t.Fatalf("expected %s, got %s", tt.Expect, extra)
+			}
+		})
+	}
+}
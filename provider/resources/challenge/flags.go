@@ -0,0 +1,349 @@
+package challenge
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/ctfer-io/go-ctfd/api"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/utils"
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/validators"
+)
+
+// CreateChallengeFlags creates flags from plan in CTFd and returns the updated list with IDs.
+func CreateChallengeFlags(ctx context.Context, client *utils.Client, challengeID int, flagsFromPlan []FlagSubresourceModel) ([]FlagSubresourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	result := make([]FlagSubresourceModel, 0, len(flagsFromPlan))
+
+	for _, flagModel := range flagsFromPlan {
+		created, err := client.PostFlags(&api.PostFlagsParams{
+			Challenge: challengeID,
+			Content:   flagModel.Content.ValueString(),
+			Data:      FlagData(flagModel),
+			Type:      flagType(flagModel).ValueString(),
+		}, client.Options(ctx)...)
+		if err != nil {
+			diags.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to create flag for challenge %d, got error: %s", challengeID, err),
+			)
+			continue
+		}
+
+		result = append(result, FromAPIFlag(created, flagModel))
+	}
+
+	return result, diags
+}
+
+// ReadChallengeFlags retrieves the flags of a challenge from CTFd.
+// Flags are returned in the order of priorFlags (matched by ID, else by
+// type and content if not tracked yet) such that
+// no diff is produced by CTFd ordering, then the ones unknown from prior
+// state (e.g. created through the web UI) are appended.
+// If priorFlags is nil, the flags are not managed inline (e.g. through
+// ctfd_flag resources) thus are not read.
+func ReadChallengeFlags(ctx context.Context, client *utils.Client, challengeID int, priorFlags []FlagSubresourceModel) ([]FlagSubresourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if priorFlags == nil {
+		return nil, diags
+	}
+
+	flags, err := client.GetChallengeFlags(challengeID, client.Options(ctx)...)
+	if err != nil {
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read flags of challenge %d, got error: %s", challengeID, err),
+		)
+		return nil, diags
+	}
+	byID := make(map[int64]*api.Flag, len(flags))
+	for _, flag := range flags {
+		byID[int64(flag.ID)] = flag
+	}
+
+	result := make([]FlagSubresourceModel, 0, len(flags))
+	for _, prior := range priorFlags {
+		if prior.ID.IsNull() {
+			// Not tracked yet (e.g. moved from a former single flag), adopt
+			// the same one
+			for id, flag := range byID {
+				if flag.Type == flagType(prior).ValueString() && flag.Content == prior.Content.ValueString() {
+					prior.ID = types.Int64Value(id)
+					break
+				}
+			}
+		}
+		flag, ok := byID[prior.ID.ValueInt64()]
+		if prior.ID.IsNull() || prior.ID.IsUnknown() || !ok {
+			// Deleted outside of Terraform
+			continue
+		}
+		result = append(result, FromAPIFlag(flag, prior))
+		delete(byID, prior.ID.ValueInt64())
+	}
+	for _, flag := range flags {
+		if _, ok := byID[int64(flag.ID)]; !ok {
+			continue
+		}
+		result = append(result, FromAPIFlag(flag, FlagSubresourceModel{}))
+	}
+
+	return result, diags
+}
+
+// SyncChallengeFlagsOnUpdate handles flag updates by keeping the unchanged ones,
+// patching the modified ones in place, creating the new ones and deleting the
+// removed ones.
+func SyncChallengeFlagsOnUpdate(ctx context.Context, client *utils.Client, challengeID int, oldFlags, newFlags []FlagSubresourceModel) ([]FlagSubresourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Keep flags that did not change, first at the same index as planned
+	// by PlanFlagIDs, then wherever they moved
+	result := make([]FlagSubresourceModel, len(newFlags))
+	matched := make([]bool, len(newFlags))
+	kept := make([]bool, len(oldFlags))
+	for i, oldFlag := range oldFlags {
+		if i < len(newFlags) && sameFlag(oldFlag, newFlags[i]) {
+			matched[i], kept[i] = true, true
+			result[i] = newFlags[i]
+			result[i].ID = oldFlag.ID
+		}
+	}
+	remaining := make([]FlagSubresourceModel, 0, len(oldFlags))
+	for j, oldFlag := range oldFlags {
+		if kept[j] {
+			continue
+		}
+		idx := -1
+		for i, newFlag := range newFlags {
+			if !matched[i] && sameFlag(oldFlag, newFlag) {
+				idx = i
+				break
+			}
+		}
+		if idx == -1 {
+			remaining = append(remaining, oldFlag)
+			continue
+		}
+		matched[idx] = true
+		result[idx] = newFlags[idx]
+		result[idx].ID = oldFlag.ID
+	}
+
+	// Patch changed flags in place, and create the additional ones
+	for i, newFlag := range newFlags {
+		if matched[i] {
+			continue
+		}
+
+		if len(remaining) != 0 {
+			oldFlag := remaining[0]
+			remaining = remaining[1:]
+
+			id := strconv.Itoa(int(oldFlag.ID.ValueInt64()))
+			patched, err := client.PatchFlag(id, &api.PatchFlagParams{
+				Content: newFlag.Content.ValueString(),
+				Data:    FlagData(newFlag),
+				ID:      id,
+				Type:    flagType(newFlag).ValueString(),
+			}, client.Options(ctx)...)
+			if err != nil {
+				diags.AddError(
+					"Client Error",
+					fmt.Sprintf("Unable to update flag %s of challenge %d, got error: %s", id, challengeID, err),
+				)
+				// Still exists as it was
+				result[i] = oldFlag
+				continue
+			}
+			result[i] = FromAPIFlag(patched, newFlag)
+			continue
+		}
+
+		created, createDiags := CreateChallengeFlags(ctx, client, challengeID, []FlagSubresourceModel{newFlag})
+		diags.Append(createDiags...)
+		if len(created) != 0 {
+			result[i] = created[0]
+		}
+	}
+
+	// Drop the flags that failed to be created, they don't exist
+	synced := make([]FlagSubresourceModel, 0, len(result))
+	for _, flag := range result {
+		if !flag.ID.IsNull() {
+			synced = append(synced, flag)
+		}
+	}
+
+	// Delete flags that are no longer in the new config
+	for _, oldFlag := range remaining {
+		if err := client.DeleteFlag(strconv.Itoa(int(oldFlag.ID.ValueInt64())), client.Options(ctx)...); err != nil {
+			diags.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to delete flag %d of challenge %d, got error: %s", oldFlag.ID.ValueInt64(), challengeID, err),
+			)
+			// Still exists, keep tracking it
+			synced = append(synced, oldFlag)
+		}
+	}
+
+	if newFlags == nil && len(synced) == 0 {
+		return nil, diags
+	}
+	return synced, diags
+}
+
+// PlanFlagIDs plans the identifier of the flags listed at p that are
+// unchanged at the same index from state, as SyncChallengeFlagsOnUpdate
+// keeps them, such that it is not unknown at each change of the list.
+func PlanFlagIDs(ctx context.Context, state tfsdk.State, plan *tfsdk.Plan, p path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if state.Raw.IsNull() {
+		return diags
+	}
+
+	var planFlags, stateFlags types.List
+	diags.Append(plan.GetAttribute(ctx, p, &planFlags)...)
+	diags.Append(state.GetAttribute(ctx, p, &stateFlags)...)
+	if diags.HasError() || planFlags.IsNull() || planFlags.IsUnknown() || stateFlags.IsNull() {
+		return diags
+	}
+
+	for i := range min(len(planFlags.Elements()), len(stateFlags.Elements())) {
+		fp := p.AtListIndex(i)
+
+		// Only the attributes sameFlag compares are read, as the test
+		// inputs could be unknown
+		var planFlag, stateFlag FlagSubresourceModel
+		for name, dst := range map[string][2]any{
+			"id":      {&planFlag.ID, &stateFlag.ID},
+			"type":    {&planFlag.Type, &stateFlag.Type},
+			"content": {&planFlag.Content, &stateFlag.Content},
+			"case":    {&planFlag.Case, &stateFlag.Case},
+			"data":    {&planFlag.Data, &stateFlag.Data},
+		} {
+			diags.Append(plan.GetAttribute(ctx, fp.AtName(name), dst[0])...)
+			diags.Append(state.GetAttribute(ctx, fp.AtName(name), dst[1])...)
+		}
+		if diags.HasError() {
+			return diags
+		}
+
+		if planFlag.ID.IsUnknown() && !stateFlag.ID.IsNull() && sameFlag(stateFlag, planFlag) {
+			diags.Append(plan.SetAttribute(ctx, fp.AtName("id"), stateFlag.ID)...)
+		}
+	}
+	return diags
+}
+
+// ValidateFlagConfig checks at plan time the content of a regex flag
+// configured at p compiles as CTFd would, and matches its test inputs.
+// caseInsensitive tells whether CTFd ignores the case of submissions.
+func ValidateFlagConfig(ctx context.Context, config tfsdk.Config, p path.Path, caseInsensitive types.Bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var typ, content types.String
+	var inputs types.List
+	diags.Append(config.GetAttribute(ctx, p.AtName("type"), &typ)...)
+	diags.Append(config.GetAttribute(ctx, p.AtName("content"), &content)...)
+	diags.Append(config.GetAttribute(ctx, p.AtName("test_inputs"), &inputs)...)
+	if diags.HasError() || typ.IsUnknown() {
+		return diags
+	}
+
+	if !typ.Equal(FlagTypeRegex) {
+		if !inputs.IsNull() {
+			diags.AddAttributeError(
+				p.AtName("test_inputs"),
+				"Invalid Flag Attribute",
+				"Test inputs are only supported by regex flags.",
+			)
+		}
+		return diags
+	}
+	if content.IsNull() || content.IsUnknown() || caseInsensitive.IsUnknown() {
+		return diags
+	}
+
+	// Test inputs are only checked once all known
+	values := []string{}
+	if !inputs.IsNull() && !inputs.IsUnknown() {
+		for _, input := range inputs.Elements() {
+			str, ok := input.(types.String)
+			if !ok || str.IsUnknown() {
+				values = nil
+				break
+			}
+			values = append(values, str.ValueString())
+		}
+	}
+	diags.Append(validators.ValidateRegexFlag(p.AtName("content"), content.ValueString(), caseInsensitive.ValueBool(), p.AtName("test_inputs"), values)...)
+
+	return diags
+}
+
+// FromAPIFlag returns the model of a CTFd flag.
+// The attributes CTFd does not store are kept from prior, i.e. the data
+// of static and regex flags (their case is stored instead), the case of
+// other flags, and the test inputs.
+func FromAPIFlag(flag *api.Flag, prior FlagSubresourceModel) FlagSubresourceModel {
+	model := FlagSubresourceModel{
+		ID:         types.Int64Value(int64(flag.ID)),
+		Type:       types.StringValue(flag.Type),
+		Content:    types.StringValue(flag.Content),
+		Case:       prior.Case,
+		Data:       types.StringValue(flag.Data),
+		TestInputs: prior.TestInputs,
+	}
+	if caseful(model.Type) {
+		model.Case = FlagCaseSensitive
+		if flag.Data == FlagCaseInsensitive.ValueString() {
+			model.Case = FlagCaseInsensitive
+		}
+		model.Data = prior.Data
+		if model.Data.IsNull() || model.Data.IsUnknown() {
+			model.Data = types.StringValue("")
+		}
+	}
+	if model.Case.IsNull() || model.Case.IsUnknown() {
+		model.Case = FlagCaseInsensitive
+	}
+	return model
+}
+
+// FlagData returns the data of a flag to send to CTFd: its case for
+// static and regex flags, else its data as is.
+func FlagData(flag FlagSubresourceModel) string {
+	if caseful(flagType(flag)) {
+		if flag.Case.Equal(FlagCaseSensitive) {
+			return ""
+		}
+		return FlagCaseInsensitive.ValueString()
+	}
+	return flag.Data.ValueString()
+}
+
+// caseful returns whether a flag type handles case sensitivity.
+func caseful(typ types.String) bool {
+	return typ.Equal(FlagTypeStatic) || typ.Equal(FlagTypeRegex)
+}
+
+func flagType(flag FlagSubresourceModel) types.String {
+	if flag.Type.IsNull() || flag.Type.IsUnknown() {
+		return FlagTypeStatic
+	}
+	return flag.Type
+}
+
+func sameFlag(a, b FlagSubresourceModel) bool {
+	return flagType(a).Equal(flagType(b)) &&
+		a.Content.Equal(b.Content) &&
+		a.Case.Equal(b.Case) &&
+		a.Data.Equal(b.Data)
+}
@@ -0,0 +1,123 @@
+package challenge
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func Test_U_PlanFlagIDs(t *testing.T) {
+	var tests = map[string]struct {
+		State    []FlagSubresourceModel
+		Plan     []FlagSubresourceModel
+		Expected []int64 // 0 if unknown
+	}{
+		"unchanged": {
+			State:    []FlagSubresourceModel{testFlag(1, "a"), testFlag(2, "b")},
+			Plan:     []FlagSubresourceModel{testFlag(0, "a"), testFlag(0, "b")},
+			Expected: []int64{1, 2},
+		},
+		"changed": {
+			State:    []FlagSubresourceModel{testFlag(1, "a"), testFlag(2, "b")},
+			Plan:     []FlagSubresourceModel{testFlag(0, "a"), testFlag(0, "c")},
+			Expected: []int64{1, 0},
+		},
+		"appended": {
+			State:    []FlagSubresourceModel{testFlag(1, "a")},
+			Plan:     []FlagSubresourceModel{testFlag(0, "a"), testFlag(0, "b")},
+			Expected: []int64{1, 0},
+		},
+		"moved": {
+			State:    []FlagSubresourceModel{testFlag(1, "a"), testFlag(2, "b")},
+			Plan:     []FlagSubresourceModel{testFlag(0, "b"), testFlag(0, "a")},
+			Expected: []int64{0, 0},
+		},
+		"removed": {
+			State:    []FlagSubresourceModel{testFlag(1, "a"), testFlag(2, "b")},
+			Plan:     []FlagSubresourceModel{testFlag(0, "a")},
+			Expected: []int64{1},
+		},
+	}
+
+	for testname, tt := range tests {
+		t.Run(testname, func(t *testing.T) {
+			ctx := context.Background()
+			s := testSchema(ctx)
+
+			prior := testChallenge(tt.State...)
+			prior.ID = types.StringValue("1")
+			state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+			if diags := state.Set(ctx, prior); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			planned := testChallenge(unknownIDs(tt.Plan)...)
+			planned.ID = types.StringValue("1")
+			plan := tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+			if diags := plan.Set(ctx, planned); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if diags := PlanFlagIDs(ctx, state, &plan, path.Root("flags")); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			var got ChallengeResourceModel
+			if diags := plan.Get(ctx, &got); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			for i, flag := range got.Flags {
+				if tt.Expected[i] == 0 {
+					if !flag.ID.IsUnknown() {
+						t.Errorf("expected flag %d ID to be unknown, got %s", i, flag.ID)
+					}
+				} else if !flag.ID.Equal(types.Int64Value(tt.Expected[i])) {
+					t.Errorf("expected flag %d ID to be %d, got %s", i, tt.Expected[i], flag.ID)
+				}
+			}
+		})
+	}
+}
+
+func Test_U_SyncChallengeFlagsSameIndex(t *testing.T) {
+	ctx := context.Background()
+	client := newPartialFailureServer(t)
+
+	// Flag 3 is kept at the same index as planned, although flag 1 is
+	// the same, such that flag 1 is patched instead
+	old := []FlagSubresourceModel{testFlag(1, "a"), testFlag(2, "b"), testFlag(3, "a")}
+	synced, diags := SyncChallengeFlagsOnUpdate(ctx, client, 1, old, []FlagSubresourceModel{
+		testFlag(0, "x"), testFlag(0, "b"), testFlag(0, "a"),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	expected := []int64{1, 2, 3}
+	if len(synced) != len(expected) {
+		t.Fatalf("expected flags %v, got %v", expected, synced)
+	}
+	for i, flag := range synced {
+		if flag.ID.ValueInt64() != expected[i] {
+			t.Fatalf("expected flags %v, got %v", expected, synced)
+		}
+	}
+}
+
+func testSchema(ctx context.Context) schema.Schema {
+	schemaResp := &resource.SchemaResponse{}
+	(&challengeResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func unknownIDs(flags []FlagSubresourceModel) []FlagSubresourceModel {
+	for i := range flags {
+		flags[i].ID = types.Int64Unknown()
+	}
+	return flags
+}
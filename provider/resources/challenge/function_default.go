@@ -0,0 +1,42 @@
+package challenge
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ planmodifier.String = (*functionDefaultModifier)(nil)
+
+// functionDefaultModifier plans the decay function of a challenge when
+// not configured: linear for a dynamic challenge, else null as other
+// types have none.
+type functionDefaultModifier struct{}
+
+func (functionDefaultModifier) Description(context.Context) string {
+	return "Defaults to linear for dynamic challenges."
+}
+
+func (m functionDefaultModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (functionDefaultModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var challType types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &challType)...)
+	if resp.Diagnostics.HasError() || challType.IsUnknown() {
+		return
+	}
+
+	if challType.Equal(TypeDynamic) {
+		resp.PlanValue = FunctionLinear
+		return
+	}
+	resp.PlanValue = types.StringNull()
+}
@@ -0,0 +1,42 @@
+package challenge
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// legacyChallengeTypes maps the former challenge resources to the
+// type of challenge they managed.
+var legacyChallengeTypes = map[string]string{
+	"ctfd_challenge_standard": TypeStandard.ValueString(),
+	"ctfd_challenge_dynamic":  TypeDynamic.ValueString(),
+}
+
+// MoveState moves the state of the former ctfd_challenge_standard and
+// ctfd_challenge_dynamic resources to ctfd_challenge, such that the
+// challenge (and its solves) is kept.
+// Their raw state is completed with the type of challenge, their former
+// single flag turns into the list of flags, and the missing attributes
+// default to null.
+func (r *challengeResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				challType, ok := legacyChallengeTypes[req.SourceTypeName]
+				if !ok || !strings.HasSuffix(req.SourceProviderAddress, "/ctfd") {
+					return
+				}
+
+				resp.Diagnostics.Append(convertRawState(ctx, req.SourceRawState, &resp.TargetState, func(attrs map[string]any) diag.Diagnostics {
+					attrs["type"] = challType
+					diags := flagToFlags(attrs)
+					diags.Append(prerequisitesToNumbers(attrs)...)
+					return diags
+				})...)
+			},
+		},
+	}
+}
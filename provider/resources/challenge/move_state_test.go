@@ -0,0 +1,245 @@
+package challenge
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// States as written by the former ctfd_challenge_standard and
+// ctfd_challenge_dynamic resources.
+const (
+	legacyStandardState = `{
+	"id": "12",
+	"name": "Stairway to Heaven",
+	"category": "misc",
+	"description": "...",
+	"attribution": null,
+	"connection_info": "",
+	"max_attempts": 0,
+	"value": 500,
+	"logic": "any",
+	"state": "visible",
+	"next": null,
+	"requirements": {
+		"behavior": "anonymized",
+		"prerequisites": ["3", "7"]
+	},
+	"flag": {
+		"type": "static",
+		"case": "case_insensitive",
+		"flag": "CTF{stairway}"
+	},
+	"tags": ["misc", "music"],
+	"topics": [],
+	"files": [
+		{
+			"id": 4,
+			"name": "lyrics.txt",
+			"path": "./lyrics.txt",
+			"type": "challenge",
+			"location": "a1b2c3/lyrics.txt",
+			"challenge_id": 12,
+			"url": "/files/a1b2c3/lyrics.txt",
+			"access_type": "public"
+		}
+	]
+}`
+	legacyDynamicState = `{
+	"id": "13",
+	"name": "Icarus",
+	"category": "pwn",
+	"description": "...",
+	"attribution": "ctfer",
+	"connection_info": "nc icarus 1337",
+	"max_attempts": 3,
+	"function": "logarithmic",
+	"value": 500,
+	"decay": 17,
+	"minimum": 50,
+	"logic": "any",
+	"state": "hidden",
+	"next": 12,
+	"requirements": null,
+	"flag": null,
+	"tags": [],
+	"topics": ["binary exploitation"],
+	"files": null
+}`
+)
+
+func Test_U_MoveState(t *testing.T) {
+	ctx := context.Background()
+	r := &challengeResource{}
+	sresp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, sresp)
+	movers := r.MoveState(ctx)
+	if len(movers) != 1 {
+		t.Fatalf("expected a single state mover, got %d", len(movers))
+	}
+
+	var tests = map[string]struct {
+		SourceTypeName string
+		SourceProvider string
+		SourceState    string
+		ExpectMoved    bool
+		Expect         func(t *testing.T, chall ChallengeResourceModel)
+	}{
+		"standard": {
+			SourceTypeName: "ctfd_challenge_standard",
+			SourceProvider: "registry.terraform.io/alexereh/ctfd",
+			SourceState:    legacyStandardState,
+			ExpectMoved:    true,
+			Expect: func(t *testing.T, chall ChallengeResourceModel) {
+				if !chall.ID.Equal(types.StringValue("12")) || !chall.Type.Equal(TypeStandard) {
+					t.Errorf("unexpected challenge %s of type %s", chall.ID, chall.Type)
+				}
+				if !chall.Value.Equal(types.Int64Value(500)) || !chall.Function.IsNull() {
+					t.Errorf("unexpected scoring: value %s, function %s", chall.Value, chall.Function)
+				}
+				if chall.Requirements == nil || len(chall.Requirements.Prerequisites) != 2 ||
+					!chall.Requirements.Prerequisites[0].Equal(types.Int64Value(3)) ||
+					!chall.Requirements.Prerequisites[1].Equal(types.Int64Value(7)) {
+					t.Errorf("unexpected requirements: %+v", chall.Requirements)
+				}
+				if len(chall.Flags) != 1 {
+					t.Fatalf("expected the flag to be moved, got %d flags", len(chall.Flags))
+				}
+				flag := chall.Flags[0]
+				if !flag.ID.IsNull() || !flag.Type.Equal(FlagTypeStatic) ||
+					!flag.Content.Equal(types.StringValue("CTF{stairway}")) ||
+					!flag.Case.Equal(FlagCaseInsensitive) || !flag.Data.Equal(types.StringValue("")) {
+					t.Errorf("unexpected flag: %+v", flag)
+				}
+				if len(chall.Files) != 1 || !chall.Files[0].ID.Equal(types.Int64Value(4)) ||
+					!chall.Files[0].Path.Equal(types.StringValue("./lyrics.txt")) || !chall.Files[0].SHA256.IsNull() {
+					t.Errorf("unexpected files: %+v", chall.Files)
+				}
+				if !chall.Extra.IsNull() {
+					t.Errorf("expected no extra, got %s", chall.Extra)
+				}
+			},
+		},
+		"dynamic": {
+			SourceTypeName: "ctfd_challenge_dynamic",
+			SourceProvider: "registry.terraform.io/alexereh/ctfd",
+			SourceState:    legacyDynamicState,
+			ExpectMoved:    true,
+			Expect: func(t *testing.T, chall ChallengeResourceModel) {
+				if !chall.ID.Equal(types.StringValue("13")) || !chall.Type.Equal(TypeDynamic) {
+					t.Errorf("unexpected challenge %s of type %s", chall.ID, chall.Type)
+				}
+				if !chall.Function.Equal(FunctionLogarithmic) || !chall.Decay.Equal(types.Int64Value(17)) ||
+					!chall.Minimum.Equal(types.Int64Value(50)) || !chall.Value.Equal(types.Int64Value(500)) {
+					t.Errorf("unexpected scoring: value %s, function %s, decay %s, minimum %s", chall.Value, chall.Function, chall.Decay, chall.Minimum)
+				}
+				if !chall.Next.Equal(types.Int64Value(12)) {
+					t.Errorf("unexpected next: %s", chall.Next)
+				}
+				if chall.Flags != nil || chall.Requirements != nil || chall.Files != nil {
+					t.Errorf("expected no flags, requirements nor files, got %+v, %+v and %+v", chall.Flags, chall.Requirements, chall.Files)
+				}
+			},
+		},
+		"other-provider": {
+			SourceTypeName: "ctfd_challenge_standard",
+			SourceProvider: "registry.terraform.io/someone/ctfdx",
+			SourceState:    legacyStandardState,
+			ExpectMoved:    false,
+		},
+		"other-resource": {
+			SourceTypeName: "ctfd_hint",
+			SourceProvider: "registry.terraform.io/alexereh/ctfd",
+			SourceState:    `{"id": "1"}`,
+			ExpectMoved:    false,
+		},
+	}
+
+	for testname, tt := range tests {
+		t.Run(testname, func(t *testing.T) {
+			req := resource.MoveStateRequest{
+				SourceProviderAddress: tt.SourceProvider,
+				SourceTypeName:        tt.SourceTypeName,
+				SourceSchemaVersion:   0,
+				SourceRawState: &tfprotov6.RawState{
+					JSON: []byte(tt.SourceState),
+				},
+			}
+			resp := &resource.MoveStateResponse{
+				TargetState: tfsdk.State{
+					Schema: sresp.Schema,
+					Raw:    tftypes.NewValue(sresp.Schema.Type().TerraformType(ctx), nil),
+				},
+			}
+
+			movers[0].StateMover(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if moved := !resp.TargetState.Raw.IsNull(); moved != tt.ExpectMoved {
+				t.Fatalf("expected moved: %t, got %t", tt.ExpectMoved, moved)
+			}
+			if !tt.ExpectMoved {
+				return
+			}
+
+			var chall ChallengeResourceModel
+			if diags := resp.TargetState.Get(ctx, &chall); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			tt.Expect(t, chall)
+		})
+	}
+}
+
+func Test_U_UpgradeState(t *testing.T) {
+	ctx := context.Background()
+	r := &challengeResource{}
+	sresp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, sresp)
+
+	upgrader, ok := r.UpgradeState(ctx)[0]
+	if !ok {
+		t.Fatal("expected a state upgrader from version 0")
+	}
+
+	// A version 0 state, with an attribute since removed
+	raw := `{
+	"id": "12",
+	"name": "Stairway to Heaven",
+	"category": "misc",
+	"description": "...",
+	"type": "standard",
+	"value": 500,
+	"requirements": {
+		"behavior": "hidden",
+		"prerequisites": ["3"]
+	},
+	"removed": true
+}`
+	resp := &resource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Schema: sresp.Schema,
+			Raw:    tftypes.NewValue(sresp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{JSON: []byte(raw)},
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var chall ChallengeResourceModel
+	if diags := resp.State.Get(ctx, &chall); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if chall.Requirements == nil || len(chall.Requirements.Prerequisites) != 1 || !chall.Requirements.Prerequisites[0].Equal(types.Int64Value(3)) {
+		t.Fatalf("unexpected requirements: %+v", chall.Requirements)
+	}
+}
@@ -0,0 +1,159 @@
+package challenge
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/utils"
+)
+
+// checkPrerequisites validates the prerequisites of the challenge id
+// (unknown if not created yet) exist in CTFd, and do not require it
+// back, even indirectly, else it could never be unlocked.
+// The prerequisites of id are recorded as planned, and the requirements
+// graph is walked with those planned for the other challenges in place
+// of the CTFd ones, such that a cycle formed by several challenges
+// planned together is reported at plan time, on the latter planned.
+// Cycles through challenges created in the same plan are already
+// prevented by Terraform as they would reference each other.
+func checkPrerequisites(ctx context.Context, client *utils.Client, id types.String, preqs []types.Int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	self := -1
+	if !id.IsNull() && !id.IsUnknown() {
+		self = utils.Atoi(id.ValueString())
+	}
+	g := &requirementsGraph{
+		ctx:    ctx,
+		client: client,
+		preqs:  map[int][]int{},
+	}
+	if self != -1 {
+		// Challenges not created yet are referenced by unknown values,
+		// and could not be part of a cycle
+		planned := []int{}
+		for _, preq := range preqs {
+			if !preq.IsNull() && !preq.IsUnknown() {
+				planned = append(planned, int(preq.ValueInt64()))
+			}
+		}
+		g.preqs = client.Requirements.Plan(self, planned)
+	}
+
+	for i, preq := range preqs {
+		if preq.IsNull() || preq.IsUnknown() {
+			continue
+		}
+		p := path.Root("requirements").AtName("prerequisites").AtListIndex(i)
+		preqID := int(preq.ValueInt64())
+
+		if preqID == self {
+			diags.AddAttributeError(p,
+				"Invalid Prerequisite",
+				fmt.Sprintf("Challenge %d requires itself, it could never be unlocked.", self),
+			)
+			continue
+		}
+		if _, err := g.prerequisites(preqID); err != nil {
+			if utils.IsNotFound(err) {
+				diags.AddAttributeError(p,
+					"Invalid Prerequisite",
+					fmt.Sprintf("Challenge %d does not exist.", preqID),
+				)
+				continue
+			}
+			diags.AddAttributeError(p,
+				"Client Error",
+				fmt.Sprintf("Unable to read challenge %d requirements, got error: %s", preqID, err),
+			)
+			continue
+		}
+
+		// A challenge not created yet could not be required by others
+		if self == -1 {
+			continue
+		}
+		cycle, err := g.path(preqID, self, map[int]bool{})
+		if err != nil {
+			diags.AddAttributeError(p,
+				"Client Error",
+				fmt.Sprintf("Unable to walk the requirements of challenge %d, got error: %s", preqID, err),
+			)
+			continue
+		}
+		if cycle != nil {
+			steps := make([]string, 0, len(cycle)+1)
+			steps = append(steps, strconv.Itoa(self))
+			for _, step := range cycle {
+				steps = append(steps, strconv.Itoa(step))
+			}
+			diags.AddAttributeError(p,
+				"Requirements Cycle",
+				fmt.Sprintf("Challenge %d requires this challenge back (%s), it could never be unlocked.", preqID, strings.Join(steps, " -> ")),
+			)
+		}
+	}
+
+	return diags
+}
+
+// requirementsGraph lazily reads the prerequisites of challenges from
+// CTFd, each one at most once, unless preqs already holds them.
+type requirementsGraph struct {
+	ctx    context.Context
+	client *utils.Client
+	preqs  map[int][]int
+}
+
+func (g *requirementsGraph) prerequisites(id int) ([]int, error) {
+	if preqs, ok := g.preqs[id]; ok {
+		return preqs, nil
+	}
+	reqs, err := g.client.GetChallengeRequirements(id, g.client.Options(g.ctx)...)
+	if err != nil {
+		return nil, err
+	}
+	preqs := []int{}
+	if reqs != nil {
+		preqs = reqs.Prerequisites
+	}
+	g.preqs[id] = preqs
+	return preqs, nil
+}
+
+// path returns the challenges from one to another following their
+// prerequisites, or nil if to is not required by from.
+func (g *requirementsGraph) path(from, to int, visited map[int]bool) ([]int, error) {
+	if from == to {
+		return []int{to}, nil
+	}
+	if visited[from] {
+		return nil, nil
+	}
+	visited[from] = true
+
+	preqs, err := g.prerequisites(from)
+	if err != nil {
+		if utils.IsNotFound(err) {
+			// Dangling prerequisite, not our concern here
+			return nil, nil
+		}
+		return nil, err
+	}
+	for _, preq := range preqs {
+		sub, err := g.path(preq, to, visited)
+		if err != nil {
+			return nil, err
+		}
+		if sub != nil {
+			return append([]int{from}, sub...), nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,204 @@
+package challenge
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ctfer-io/go-ctfd/api"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/utils"
+)
+
+// newRequirementsServer serves the prerequisites of the challenges of
+// graph, and 404 for the other ones.
+func newRequirementsServer(t *testing.T, graph map[string][]int) *utils.Client {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/v1/challenges/"), "/requirements")
+		preqs, ok := graph[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"success": true,
+			"data": map[string]any{
+				"prerequisites": preqs,
+			},
+		})
+	}))
+	t.Cleanup(srv.Close)
+
+	return utils.NewClient(api.NewClient(srv.URL, "", "", "key"), &utils.NotFoundTransport{
+		Base: http.DefaultTransport,
+	})
+}
+
+func Test_U_RequirementsGraphPath(t *testing.T) {
+	// 1 <- 2 <- 3 <- 4, 5 <- 6 -> 7 (missing), and 8 <-> 9
+	client := newRequirementsServer(t, map[string][]int{
+		"1": {},
+		"2": {1},
+		"3": {2},
+		"4": {3},
+		"5": {},
+		"6": {5, 7},
+		"8": {9},
+		"9": {8},
+	})
+
+	var tests = map[string]struct {
+		From, To int
+		Expect   []int
+	}{
+		"self": {
+			From:   1,
+			To:     1,
+			Expect: []int{1},
+		},
+		"direct": {
+			From:   2,
+			To:     1,
+			Expect: []int{2, 1},
+		},
+		"indirect": {
+			From:   4,
+			To:     1,
+			Expect: []int{4, 3, 2, 1},
+		},
+		"reverse": {
+			From:   1,
+			To:     4,
+			Expect: nil,
+		},
+		"disconnected": {
+			From:   6,
+			To:     1,
+			Expect: nil,
+		},
+		"dangling": {
+			From:   6,
+			To:     7,
+			Expect: []int{6, 7},
+		},
+		"through-dangling": {
+			From:   6,
+			To:     4,
+			Expect: nil,
+		},
+		"existing-cycle": {
+			From:   8,
+			To:     1,
+			Expect: nil,
+		},
+	}
+
+	for testname, tt := range tests {
+		t.Run(testname, func(t *testing.T) {
+			g := &requirementsGraph{
+				ctx:    context.Background(),
+				client: client,
+				preqs:  map[int][]int{},
+			}
+
+			path, err := g.path(tt.From, tt.To, map[int]bool{})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(path, tt.Expect) {
+				t.Fatalf("expected %v, got %v", tt.Expect, path)
+			}
+		})
+	}
+}
+
+func Test_U_CheckPrerequisites(t *testing.T) {
+	var tests = map[string]struct {
+		ID            types.String
+		Prerequisites []int64
+		Planned       map[int][]int
+		ExpectErrors  []string
+	}{
+		"valid": {
+			ID:            types.StringValue("3"),
+			Prerequisites: []int64{1, 2},
+		},
+		"not-created": {
+			ID:            types.StringUnknown(),
+			Prerequisites: []int64{3},
+		},
+		"itself": {
+			ID:            types.StringValue("3"),
+			Prerequisites: []int64{3},
+			ExpectErrors:  []string{"Invalid Prerequisite"},
+		},
+		"missing": {
+			ID:            types.StringValue("3"),
+			Prerequisites: []int64{1, 42},
+			ExpectErrors:  []string{"Invalid Prerequisite"},
+		},
+		"cycle": {
+			ID:            types.StringValue("1"),
+			Prerequisites: []int64{3},
+			ExpectErrors:  []string{"Requirements Cycle"},
+		},
+		"planned-mutual": {
+			ID:            types.StringValue("5"),
+			Prerequisites: []int64{4},
+			Planned:       map[int][]int{4: {5}},
+			ExpectErrors:  []string{"Requirements Cycle"},
+		},
+		"planned-cycle": {
+			ID:            types.StringValue("2"),
+			Prerequisites: []int64{1},
+			Planned:       map[int][]int{1: {3}},
+			ExpectErrors:  []string{"Requirements Cycle"},
+		},
+		"planned-removal": {
+			ID:            types.StringValue("1"),
+			Prerequisites: []int64{3},
+			Planned:       map[int][]int{2: {}},
+		},
+	}
+
+	for testname, tt := range tests {
+		t.Run(testname, func(t *testing.T) {
+			// 1 <- 2 <- 3, and 4 5
+			client := newRequirementsServer(t, map[string][]int{
+				"1": {},
+				"2": {1},
+				"3": {2},
+				"4": {},
+				"5": {},
+			})
+			for id, planned := range tt.Planned {
+				_ = client.Requirements.Plan(id, planned)
+			}
+
+			preqs := make([]types.Int64, 0, len(tt.Prerequisites))
+			for _, preq := range tt.Prerequisites {
+				preqs = append(preqs, types.Int64Value(preq))
+			}
+
+			diags := checkPrerequisites(context.Background(), client, tt.ID, preqs)
+
+			summaries := []string{}
+			for _, d := range diags.Errors() {
+				summaries = append(summaries, d.Summary())
+				if dp, ok := d.(diag.DiagnosticWithPath); !ok || !strings.HasPrefix(dp.Path().String(), "requirements.prerequisites[") {
+					t.Errorf("expected the error on a prerequisite, got %v", d)
+				}
+			}
+			if len(summaries) != len(tt.ExpectErrors) || (len(summaries) != 0 && !reflect.DeepEqual(summaries, tt.ExpectErrors)) {
+				t.Fatalf("expected errors %v, got %v", tt.ExpectErrors, diags)
+			}
+		})
+	}
+}
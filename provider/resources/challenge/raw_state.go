@@ -0,0 +1,120 @@
+package challenge
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// convertRawState decodes the raw state of a challenge, applies convert
+// on its attributes, then sets it as state with the current schema.
+// Attributes missing from the raw state default to null, and the ones
+// the current schema no longer defines are dropped.
+func convertRawState(ctx context.Context, raw *tfprotov6.RawState, state *tfsdk.State, convert func(attrs map[string]any) diag.Diagnostics) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if raw == nil {
+		diags.AddError(
+			"Unable to Convert Resource State",
+			"No state to convert.",
+		)
+		return diags
+	}
+
+	attrs := map[string]any{}
+	dec := json.NewDecoder(bytes.NewReader(raw.JSON))
+	dec.UseNumber()
+	if err := dec.Decode(&attrs); err != nil {
+		diags.AddError(
+			"Unable to Convert Resource State",
+			fmt.Sprintf("Unable to decode the state: %s", err),
+		)
+		return diags
+	}
+	diags.Append(convert(attrs)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	b, err := json.Marshal(attrs)
+	if err != nil {
+		diags.AddError(
+			"Unable to Convert Resource State",
+			fmt.Sprintf("Unable to encode the state: %s", err),
+		)
+		return diags
+	}
+	val, err := (tfprotov6.RawState{JSON: b}).UnmarshalWithOpts(state.Schema.Type().TerraformType(ctx), tfprotov6.UnmarshalOpts{
+		ValueFromJSONOpts: tftypes.ValueFromJSONOpts{
+			IgnoreUndefinedAttributes: true,
+		},
+	})
+	if err != nil {
+		diags.AddError(
+			"Unable to Convert Resource State",
+			fmt.Sprintf("Unable to convert the state to the current schema: %s", err),
+		)
+		return diags
+	}
+	state.Raw = val
+	return diags
+}
+
+// prerequisitesToNumbers converts the prerequisites of a raw state from
+// strings, as they were before being typed, to numbers.
+func prerequisitesToNumbers(attrs map[string]any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	reqs, ok := attrs["requirements"].(map[string]any)
+	if !ok {
+		return diags
+	}
+	preqs, ok := reqs["prerequisites"].([]any)
+	if !ok {
+		return diags
+	}
+	for i, preq := range preqs {
+		str, ok := preq.(string)
+		if !ok {
+			continue
+		}
+		id, err := strconv.Atoi(str)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("requirements").AtName("prerequisites").AtListIndex(i),
+				"Invalid Prerequisite",
+				fmt.Sprintf("Prerequisite %q is not a challenge ID: %s", str, err),
+			)
+			continue
+		}
+		preqs[i] = id
+	}
+	return diags
+}
+
+// flagToFlags converts the single flag of a raw state, as managed before
+// challenges supported several flags, to a list of one flag.
+// As its CTFd ID was not tracked, it is adopted on next read.
+func flagToFlags(attrs map[string]any) diag.Diagnostics {
+	flag, ok := attrs["flag"].(map[string]any)
+	delete(attrs, "flag")
+	if !ok || attrs["flags"] != nil {
+		return nil
+	}
+	attrs["flags"] = []any{
+		map[string]any{
+			"type":    flag["type"],
+			"content": flag["flag"],
+			"case":    flag["case"],
+			"data":    "",
+		},
+	}
+	return nil
+}
@@ -0,0 +1,835 @@
+package challenge
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/ctfer-io/go-ctfd/api"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/utils"
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/validators"
+)
+
+var (
+	_ resource.Resource                   = (*challengeResource)(nil)
+	_ resource.ResourceWithConfigure      = (*challengeResource)(nil)
+	_ resource.ResourceWithImportState    = (*challengeResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*challengeResource)(nil)
+	_ resource.ResourceWithMoveState      = (*challengeResource)(nil)
+	_ resource.ResourceWithUpgradeState   = (*challengeResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*challengeResource)(nil)
+)
+
+func NewChallengeResource() resource.Resource {
+	return &challengeResource{}
+}
+
+type challengeResource struct {
+	client *utils.Client
+}
+
+// ChallengeResourceModel is exported for ease of extending
+// CTFd through a plugin. Under normal circumpstances, you should
+// not use it.
+type ChallengeResourceModel struct {
+	ID             types.String                  `tfsdk:"id"`
+	Name           types.String                  `tfsdk:"name"`
+	Category       types.String                  `tfsdk:"category"`
+	Description    types.String                  `tfsdk:"description"`
+	Attribution    types.String                  `tfsdk:"attribution"`
+	ConnectionInfo types.String                  `tfsdk:"connection_info"`
+	MaxAttempts    types.Int64                   `tfsdk:"max_attempts"`
+	Type           types.String                  `tfsdk:"type"`
+	Value          types.Int64                   `tfsdk:"value"`
+	Function       types.String                  `tfsdk:"function"`
+	Decay          types.Int64                   `tfsdk:"decay"`
+	Minimum        types.Int64                   `tfsdk:"minimum"`
+	Logic          types.String                  `tfsdk:"logic"`
+	State          types.String                  `tfsdk:"state"`
+	Next           types.Int64                   `tfsdk:"next"`
+	Requirements   *RequirementsSubresourceModel `tfsdk:"requirements"`
+	Flags          []FlagSubresourceModel        `tfsdk:"flags"`
+	Tags           []types.String                `tfsdk:"tags"`
+	Topics         []types.String                `tfsdk:"topics"`
+	// Attached files (subresource) for the challenge.
+	Files []FileSubresourceModel `tfsdk:"files"`
+	// Extra attributes of the challenge type, as a JSON object.
+	Extra types.String `tfsdk:"extra"`
+}
+
+func (r *challengeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_challenge"
+}
+
+func (r *challengeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CTFd is built around the Challenge resource, which contains all the attributes to define a part of the Capture The Flag event.\n\nIt supports the standard challenges, the dynamic ones which value decays through solves, and the ones provided by CTFd plugins (e.g. instance-based or multi-question challenges) through its `type` and `extra` attributes.\n\nThe former `ctfd_challenge_standard` and `ctfd_challenge_dynamic` resources could be moved to it with a `moved` block, without re-creating the challenge.",
+		Attributes:          ChallengeResourceAttributes,
+		Version:             1,
+	}
+}
+
+func (r *challengeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*utils.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *github.com/AlexEreh/terraform-provider-ctfd/provider/utils.Client, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *challengeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// Attributes are read one by one, as the configuration could hold
+	// unknown collections that the model can't
+	var challType, function, logic types.String
+	var decay, minimum types.Int64
+	var flags types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &challType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("function"), &function)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("decay"), &decay)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("minimum"), &minimum)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("logic"), &logic)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("flags"), &flags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// => Scoring attributes of dynamic challenges
+	if !challType.IsUnknown() {
+		if challType.IsNull() {
+			challType = TypeStandard
+		}
+		dynamic := challType.Equal(TypeDynamic)
+		for name, value := range map[string]attr.Value{
+			"function": function,
+			"decay":    decay,
+			"minimum":  minimum,
+		} {
+			switch {
+			case !dynamic && !value.IsNull():
+				resp.Diagnostics.AddAttributeError(
+					path.Root(name),
+					"Invalid Challenge Attribute",
+					fmt.Sprintf("Attribute %s is only supported by dynamic challenges, got type %s.", name, challType.ValueString()),
+				)
+			case dynamic && name != "function" && value.IsNull():
+				resp.Diagnostics.AddAttributeError(
+					path.Root(name),
+					"Missing Challenge Attribute",
+					fmt.Sprintf("Attribute %s is required by dynamic challenges.", name),
+				)
+			}
+		}
+	}
+
+	// => Flags, if managed inline
+	if !flags.IsNull() && !flags.IsUnknown() {
+		for i := range flags.Elements() {
+			p := path.Root("flags").AtListIndex(i)
+
+			var flagCase types.String
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, p.AtName("case"), &flagCase)...)
+			caseInsensitive := types.BoolUnknown()
+			if !flagCase.IsUnknown() {
+				caseInsensitive = types.BoolValue(!flagCase.Equal(FlagCaseSensitive))
+			}
+			resp.Diagnostics.Append(ValidateFlagConfig(ctx, req.Config, p, caseInsensitive)...)
+		}
+	}
+
+	// => Logic, if flags are managed inline
+	if logic.Equal(LogicAll) && !flags.IsNull() && !flags.IsUnknown() && len(flags.Elements()) < 2 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("logic"),
+			"Invalid Challenge Attribute",
+			fmt.Sprintf("Logic all requires every flag to be submitted thus at least 2 flags, got %d. Use logic any instead.", len(flags.Elements())),
+		)
+	}
+}
+
+func (r *challengeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// => Files digests, and computed attributes of the unchanged ones
+	resp.Diagnostics.Append(utils.PlanFileDigests(ctx, &resp.Plan, path.Root("files"))...)
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(utils.PlanUnchangedFiles(ctx, req.State, &resp.Plan, path.Root("files"))...)
+	}
+
+	// => Flags identifiers of the unchanged ones
+	resp.Diagnostics.Append(PlanFlagIDs(ctx, req.State, &resp.Plan, path.Root("flags"))...)
+
+	// Nothing to check without a client to check with
+	if resp.Diagnostics.HasError() || r.client == nil {
+		return
+	}
+
+	var id, logic types.String
+	var next types.Int64
+	var preqs types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("logic"), &logic)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("next"), &next)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("requirements").AtName("prerequisites"), &preqs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// => Next
+	if !id.IsNull() && !id.IsUnknown() && !next.IsNull() && !next.IsUnknown() && next.ValueInt64() == int64(utils.Atoi(id.ValueString())) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("next"),
+			"Invalid Challenge Attribute",
+			fmt.Sprintf("Challenge %s suggests itself as the next challenge to work on.", id.ValueString()),
+		)
+	}
+
+	// => Logic
+	if logic.Equal(LogicTeam) {
+		userMode, err := getUserMode(ctx, r.client)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to read configs, got error: %s", err),
+			)
+			return
+		}
+		if userMode != "teams" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("logic"),
+				"Invalid Challenge Attribute",
+				fmt.Sprintf("Logic team is only supported when CTFd runs in teams mode, got %s mode.", userMode),
+			)
+		}
+	}
+
+	// => Prerequisites, checked even if none to record it is planned so
+	if preqs.IsUnknown() {
+		return
+	}
+	ids := []types.Int64{}
+	if !preqs.IsNull() {
+		resp.Diagnostics.Append(preqs.ElementsAs(ctx, &ids, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	resp.Diagnostics.Append(checkPrerequisites(ctx, r.client, id, ids)...)
+}
+
+func (r *challengeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_challenge", "Create")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	var data ChallengeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	extra, err := parseExtra(data.Extra)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("extra"), "Invalid Extra", err.Error())
+		return
+	}
+
+	// Create Challenge
+	params := &api.PostChallengesParams{
+		Name:           data.Name.ValueString(),
+		Category:       data.Category.ValueString(),
+		Description:    data.Description.ValueString(),
+		Attribution:    data.Attribution.ValueStringPointer(),
+		ConnectionInfo: data.ConnectionInfo.ValueStringPointer(),
+		MaxAttempts:    utils.ToInt(data.MaxAttempts),
+		Value:          int(data.Value.ValueInt64()),
+		Logic:          data.Logic.ValueString(),
+		State:          data.State.ValueString(),
+		Type:           data.Type.ValueString(),
+		NextID:         utils.ToInt(data.Next),
+		Requirements:   ToAPIRequirements(data.Requirements),
+	}
+	if data.Type.Equal(TypeDynamic) {
+		params.Function = data.Function.ValueStringPointer()
+		params.Initial = utils.ToInt(data.Value)
+		params.Decay = utils.ToInt(data.Decay)
+		params.Minimum = utils.ToInt(data.Minimum)
+	}
+	res, err := utils.PostChallenges(r.client, params, extra, r.client.Options(ctx)...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create challenge, got error: %s", err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "created a challenge")
+
+	// Save computed attributes in state
+	data.ID = types.StringValue(strconv.Itoa(res.ID))
+
+	// From now on the challenge exists, so the state is saved even on
+	// errors: Terraform then tracks it as tainted rather than creating
+	// another one on the next apply.
+	defer func() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}()
+
+	// Create flags
+	if len(data.Flags) > 0 {
+		createdFlags, flagDiags := CreateChallengeFlags(ctx, r.client, res.ID, data.Flags)
+		resp.Diagnostics.Append(flagDiags...)
+		data.Flags = createdFlags
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Create tags and topics
+	resp.Diagnostics.Append(SyncChallengeTags(ctx, r.client, res.ID, data.Tags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(SyncChallengeTopics(ctx, r.client, res.ID, data.Topics)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create files
+	if len(data.Files) > 0 {
+		uploadedFiles, fileDiags := CreateChallengeFiles(ctx, r.client, res.ID, data.Files)
+		resp.Diagnostics.Append(fileDiags...)
+		data.Files = uploadedFiles
+	}
+}
+
+func (r *challengeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_challenge", "Read")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	var data ChallengeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Read(ctx, r.client, &resp.Diagnostics) {
+		// Deleted outside of Terraform, plan its re-creation
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *challengeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_challenge", "Update")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	var data ChallengeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var dataState ChallengeResourceModel
+	req.State.Get(ctx, &dataState)
+
+	extra, err := parseExtra(data.Extra)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("extra"), "Invalid Extra", err.Error())
+		return
+	}
+
+	// Check requirements again, as other challenges could have been
+	// updated outside of Terraform since plan
+	if data.Requirements != nil {
+		resp.Diagnostics.Append(checkPrerequisites(ctx, r.client, data.ID, data.Requirements.Prerequisites)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Patch direct attributes
+	id := utils.Atoi(data.ID.ValueString())
+	params := &api.PatchChallengeParams{
+		Name:           data.Name.ValueString(),
+		Category:       data.Category.ValueString(),
+		Description:    data.Description.ValueString(),
+		Attribution:    data.Attribution.ValueStringPointer(),
+		ConnectionInfo: data.ConnectionInfo.ValueStringPointer(),
+		MaxAttempts:    utils.ToInt(data.MaxAttempts),
+		Value:          utils.ToInt(data.Value),
+		Logic:          data.Logic.ValueStringPointer(),
+		State:          data.State.ValueString(),
+		NextID:         utils.ToInt(data.Next),
+		Requirements:   ToAPIRequirements(data.Requirements),
+	}
+	if data.Type.Equal(TypeDynamic) {
+		// The value of a dynamic challenge is computed by CTFd from its initial one
+		params.Value = nil
+		params.Function = data.Function.ValueStringPointer()
+		params.Initial = utils.ToInt(data.Value)
+		params.Decay = utils.ToInt(data.Decay)
+		params.Minimum = utils.ToInt(data.Minimum)
+	}
+	if _, err := utils.PatchChallenge(r.client, id, params, extra, r.client.Options(ctx)...); err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to update challenge, got error: %s", err),
+		)
+		return
+	}
+
+	// From now on the state is saved even on errors, such that the flags
+	// and files synced so far are tracked. Those not synced yet are kept
+	// as they were.
+	planFlags, planFiles := data.Flags, data.Files
+	data.Flags, data.Files = dataState.Flags, dataState.Files
+	defer func() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}()
+
+	// Update its tags and topics, only adding or removing what changed
+	resp.Diagnostics.Append(SyncChallengeTags(ctx, r.client, id, data.Tags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(SyncChallengeTopics(ctx, r.client, id, data.Topics)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update flags
+	syncedFlags, flagDiags := SyncChallengeFlagsOnUpdate(ctx, r.client, id, dataState.Flags, planFlags)
+	resp.Diagnostics.Append(flagDiags...)
+	data.Flags = syncedFlags
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update files
+	syncedFiles, fileDiags := SyncChallengeFilesOnUpdate(ctx, r.client, id, dataState.Files, planFiles)
+	resp.Diagnostics.Append(fileDiags...)
+	data.Files = syncedFiles
+}
+
+func (r *challengeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_challenge", "Delete")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	var data ChallengeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteChallenge(utils.Atoi(data.ID.ValueString()), r.client.Options(ctx)...); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete challenge, got error: %s", err))
+		return
+	}
+
+	// ... don't need to delete nested objects, this is handled by CTFd
+}
+
+func (r *challengeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	// Manage the files of the challenge, such that r.Read populates them
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("files"), []FileSubresourceModel{})...)
+
+	// Automatically call r.Read
+}
+
+//
+// Starting from this are helper or types-specific code related to the ctfd_challenge resource
+//
+
+// Read refreshes the challenge from CTFd, and returns false if it does
+// not exist anymore.
+func (chall *ChallengeResourceModel) Read(ctx context.Context, client *utils.Client, diags *diag.Diagnostics) bool {
+	res, err := client.GetChallenge(utils.Atoi(chall.ID.ValueString()), client.Options(ctx)...)
+	if err != nil {
+		if utils.IsNotFound(err) {
+			return false
+		}
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read challenge %s, got error: %s", chall.ID.ValueString(), err))
+		return true
+	}
+	chall.Name = types.StringValue(res.Name)
+	chall.Category = types.StringValue(res.Category)
+	chall.Description = types.StringValue(res.Description)
+	chall.Attribution = types.StringPointerValue(res.Attribution)
+	chall.ConnectionInfo = utils.ToTFString(res.ConnectionInfo)
+	chall.MaxAttempts = utils.ToTFInt64(res.MaxAttempts)
+	chall.Type = types.StringValue(res.Type)
+	chall.Value = types.Int64Value(int64(res.Value))
+	chall.Function = types.StringNull()
+	chall.Decay = types.Int64Null()
+	chall.Minimum = types.Int64Null()
+	if chall.Type.Equal(TypeDynamic) {
+		chall.Value = utils.ToTFInt64(res.Initial)
+		chall.Function = utils.ToTFString(res.Function)
+		chall.Decay = utils.ToTFInt64(res.Decay)
+		chall.Minimum = utils.ToTFInt64(res.Minimum)
+	}
+	chall.Logic = types.StringValue(res.Logic)
+	chall.State = types.StringValue(res.State)
+	chall.Next = utils.ToTFInt64(res.NextID)
+
+	id := utils.Atoi(chall.ID.ValueString())
+
+	// Get subresources
+	// => Requirements
+	resReqs, err := client.GetChallengeRequirements(id, client.Options(ctx)...)
+	if err != nil {
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read challenge %d requirements, got error: %s", id, err),
+		)
+		return true
+	}
+	chall.Requirements = FromAPIRequirements(resReqs)
+
+	// => Tags
+	resTags, err := client.GetChallengeTags(id, client.Options(ctx)...)
+	if err != nil {
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read challenge %d tags, got error: %s", id, err),
+		)
+		return true
+	}
+	chall.Tags = make([]basetypes.StringValue, 0, len(resTags))
+	for _, tag := range resTags {
+		chall.Tags = append(chall.Tags, types.StringValue(tag.Value))
+	}
+
+	// => Topics
+	resTopics, err := client.GetChallengeTopics(id, client.Options(ctx)...)
+	if err != nil {
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read challenge %d topics, got error: %s", id, err),
+		)
+		return true
+	}
+	chall.Topics = make([]basetypes.StringValue, 0, len(resTopics))
+	for _, topic := range resTopics {
+		chall.Topics = append(chall.Topics, types.StringValue(topic.Value))
+	}
+
+	// => Files
+	filesList, fileDiags := ReadChallengeFiles(ctx, client, id, chall.Files)
+	diags.Append(fileDiags...)
+	if diags.HasError() {
+		return true
+	}
+	chall.Files = filesList
+
+	// => Flags
+	flagsList, flagDiags := ReadChallengeFlags(ctx, client, id, chall.Flags)
+	diags.Append(flagDiags...)
+	if diags.HasError() {
+		return true
+	}
+	chall.Flags = flagsList
+
+	// => Extra attributes, unknown from api.Challenge
+	if !chall.Extra.IsNull() {
+		attrs, err := utils.GetChallengeAttributes(client, id, client.Options(ctx)...)
+		if err != nil {
+			diags.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to read challenge %d attributes, got error: %s", id, err),
+			)
+			return true
+		}
+		extra, err := readExtra(chall.Extra, attrs)
+		if err != nil {
+			diags.AddAttributeError(path.Root("extra"), "Invalid Extra", err.Error())
+			return true
+		}
+		chall.Extra = extra
+	}
+
+	return true
+}
+
+// getUserMode returns the mode CTFd runs in, either users or teams.
+func getUserMode(ctx context.Context, client *utils.Client) (string, error) {
+	configs, err := client.GetConfigs(nil, client.Options(ctx)...)
+	if err != nil {
+		return "", err
+	}
+	for _, c := range configs {
+		if c.Key == "user_mode" {
+			return c.Value, nil
+		}
+	}
+	// Not set up yet, default of CTFd
+	return "users", nil
+}
+
+var (
+	// ChallengeResourceAttributes is exported for ease of extending
+	// CTFd through a plugin. Under normal circumpstances, you should
+	// not use it.
+	ChallengeResourceAttributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Identifier of the challenge.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the challenge, displayed as it.",
+			Required:            true,
+		},
+		"category": schema.StringAttribute{
+			MarkdownDescription: "Category of the challenge that CTFd groups by on the web UI.",
+			Required:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Description of the challenge, consider using multiline descriptions for better style.",
+			Required:            true,
+		},
+		"attribution": schema.StringAttribute{
+			MarkdownDescription: "Attribution to the creator(s) of the challenge.",
+			Optional:            true,
+		},
+		"connection_info": schema.StringAttribute{
+			MarkdownDescription: "Connection Information to connect to the challenge instance, useful for pwn, web and infrastructure pentests.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(""),
+		},
+		"max_attempts": schema.Int64Attribute{
+			MarkdownDescription: "Maximum amount of attempts before being unable to flag the challenge.",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(0),
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "Type of the challenge, as registered in CTFd by its plugin (e.g. `standard`, `dynamic` or a custom one). As CTFd cannot convert a challenge from one type to another, changing it re-creates the challenge thus loses its solves.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(TypeStandard.ValueString()),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"value": schema.Int64Attribute{
+			MarkdownDescription: "The value (points) of the challenge once solved. For a dynamic challenge, it is mapped to `initial` under the hood.",
+			Required:            true,
+		},
+		"function": schema.StringAttribute{
+			MarkdownDescription: "Decay function to define how the challenge value evolve through solves, either linear or logarithmic. Only supported by dynamic challenges, default to linear.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				functionDefaultModifier{},
+			},
+			Validators: []validator.String{
+				validators.NewStringEnumValidator([]basetypes.StringValue{
+					FunctionLinear,
+					FunctionLogarithmic,
+				}),
+			},
+		},
+		"decay": schema.Int64Attribute{
+			MarkdownDescription: "The decay defines from each number of solves does the decay function triggers until reaching minimum. This function is defined by CTFd and could be configured through `.function`. Required by dynamic challenges, and must be at least 1.",
+			Optional:            true,
+			Validators: []validator.Int64{
+				validators.NewInt64AtLeastValidator(1),
+			},
+		},
+		"minimum": schema.Int64Attribute{
+			MarkdownDescription: "The minimum points for a dynamic-score challenge to reach with the decay function. Once there, no solve could have more value. Required by dynamic challenges, and must not exceed `value`.",
+			Optional:            true,
+			Validators: []validator.Int64{
+				validators.NewInt64AtLeastValidator(0),
+				validators.NewInt64AtMostAttributeValidator(path.Root("value")),
+			},
+		},
+		"logic": schema.StringAttribute{
+			MarkdownDescription: "The flag validation logic, either any, all (every flag must be submitted, thus requires at least 2 flags) or team (every team member must submit a flag, thus requires CTFd to run in teams mode).",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(LogicAny.ValueString()),
+			Validators: []validator.String{
+				validators.NewStringEnumValidator([]basetypes.StringValue{
+					LogicAny,
+					LogicAll,
+					LogicTeam,
+				}),
+			},
+		},
+		"state": schema.StringAttribute{
+			MarkdownDescription: "State of the challenge, either hidden or visible.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("hidden"),
+			Validators: []validator.String{
+				validators.NewStringEnumValidator([]basetypes.StringValue{
+					types.StringValue("hidden"),
+					types.StringValue("visible"),
+				}),
+			},
+		},
+		"next": schema.Int64Attribute{
+			MarkdownDescription: "Suggestion for the end-user as next challenge to work on.",
+			Optional:            true,
+		},
+		"requirements": schema.SingleNestedAttribute{
+			MarkdownDescription: "List of required challenges that needs to get flagged before this one being accessible. Useful for skill-trees-like strategy CTF.",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"behavior": schema.StringAttribute{
+					MarkdownDescription: "Behavior if not unlocked, either hidden or anonymized.",
+					Optional:            true,
+					Computed:            true,
+					Default:             stringdefault.StaticString("hidden"),
+					Validators: []validator.String{
+						validators.NewStringEnumValidator([]basetypes.StringValue{
+							BehaviorHidden,
+							BehaviorAnonymized,
+						}),
+					},
+				},
+				"prerequisites": schema.ListAttribute{
+					MarkdownDescription: "List of the challenges ID. They must exist in CTFd, and must not require this challenge back, even indirectly. This is checked at plan time, against the requirements planned for the other challenges or else the CTFd ones, then again before updating the challenge.",
+					Optional:            true,
+					ElementType:         types.Int64Type,
+				},
+			},
+		},
+		"flags": schema.ListNestedAttribute{
+			MarkdownDescription: "List of flags of the challenge. They are read back from CTFd so any change made through the web UI is detected. If not set, flags are not managed by this resource, so you could use `ctfd_flag` instead.",
+			Optional:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.Int64Attribute{
+						MarkdownDescription: "Identifier of the flag in CTFd.",
+						Computed:            true,
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Type of the flag (static, regex, programmable).",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(FlagTypeStatic.ValueString()),
+						Validators: []validator.String{
+							validators.NewStringEnumValidator([]basetypes.StringValue{
+								FlagTypeStatic,
+								FlagTypeRegex,
+								FlagTypeProgrammable,
+							}),
+						},
+					},
+					"content": schema.StringAttribute{
+						MarkdownDescription: "Flag content.",
+						Required:            true,
+						Sensitive:           true,
+					},
+					"case": schema.StringAttribute{
+						MarkdownDescription: "Case-sensitivity behavior of static and regex flags, either case_insensitive or case_sensitive. It is stored by CTFd as the data of the flag.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(FlagCaseInsensitive.ValueString()),
+						Validators: []validator.String{
+							validators.NewStringEnumValidator([]basetypes.StringValue{
+								FlagCaseInsensitive,
+								FlagCaseSensitive,
+							}),
+						},
+					},
+					"data": schema.StringAttribute{
+						MarkdownDescription: "Additional data of the flag, as interpreted by its type. Ignored by static and regex flags, which use `case` instead.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(""),
+					},
+					"test_inputs": schema.ListAttribute{
+						MarkdownDescription: "Submissions that must match a regex flag, checked at plan time against the regex as CTFd would (Python dialect, whole submission, with respect to `case`). They are not sent to CTFd.",
+						Optional:            true,
+						Sensitive:           true,
+						ElementType:         types.StringType,
+					},
+				},
+			},
+		},
+		"tags": schema.SetAttribute{
+			MarkdownDescription: "Set of challenge tags that will be displayed to the end-user. You could use them to give some quick insights of what a challenge involves.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			Default:             setdefault.StaticValue(basetypes.NewSetValueMust(types.StringType, []attr.Value{})),
+		},
+		"topics": schema.SetAttribute{
+			MarkdownDescription: "Set of challenge topics that are displayed to the administrators for maintenance and planification.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			Default:             setdefault.StaticValue(basetypes.NewSetValueMust(types.StringType, []attr.Value{})),
+		},
+		"files": schema.ListNestedAttribute{
+			MarkdownDescription: "List of files (attachments) associated with this challenge.",
+			Optional:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: utils.BlindMerge(utils.FileAttributes(), map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Type of the file entry in CTFd (e.g., challenge).",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(FileTypeChallenge.ValueString()),
+					},
+					"challenge_id": schema.Int64Attribute{
+						MarkdownDescription: "Challenge identifier this file is attached to.",
+						Computed:            true,
+					},
+					"access_type": schema.StringAttribute{
+						MarkdownDescription: "Access control type of the file (if exposed by the API).",
+						Computed:            true,
+					},
+				}),
+			},
+		},
+		"extra": schema.StringAttribute{
+			MarkdownDescription: "Additional attributes of the challenge type as a JSON object, e.g. `jsonencode({ image = \"...\" })`. They are merged into the CTFd requests, taking precedence over the other attributes, and read back by key.",
+			Optional:            true,
+			Validators: []validator.String{
+				validators.NewJSONObjectValidator(),
+			},
+		},
+	}
+)
@@ -0,0 +1,156 @@
+package challenge
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ctfer-io/go-ctfd/api"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/utils"
+)
+
+// newPartialFailureServer mocks CTFd for challenge 1, failing to patch
+// flag 2 and to create flags which content is "fail".
+func newPartialFailureServer(t *testing.T) *utils.Client {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+
+		var data any
+		switch r.Method + " " + r.URL.Path {
+		case "POST /api/v1/challenges", "PATCH /api/v1/challenges/1":
+			data = map[string]any{"id": 1}
+		case "GET /api/v1/challenges/1/tags", "GET /api/v1/challenges/1/topics":
+			data = []any{}
+		case "PATCH /api/v1/flags/1":
+			data = map[string]any{"id": 1, "challenge_id": 1, "type": body["type"], "content": body["content"], "data": body["data"]}
+		case "POST /api/v1/flags":
+			if body["content"] != "fail" {
+				data = map[string]any{"id": 10, "challenge_id": 1, "type": body["type"], "content": body["content"], "data": body["data"]}
+			}
+		}
+		if data == nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"success": false, "errors": ["internal error"]}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"success": true,
+			"data":    data,
+		})
+	}))
+	t.Cleanup(srv.Close)
+
+	return utils.NewClient(api.NewClient(srv.URL, "", "", "key"), http.DefaultTransport)
+}
+
+func testChallenge(flags ...FlagSubresourceModel) ChallengeResourceModel {
+	return ChallengeResourceModel{
+		Name:     types.StringValue("chall"),
+		Category: types.StringValue("misc"),
+		Type:     TypeStandard,
+		Value:    types.Int64Value(500),
+		Logic:    LogicAny,
+		State:    types.StringValue("visible"),
+		Flags:    flags,
+	}
+}
+
+func testFlag(id int64, content string) FlagSubresourceModel {
+	flag := FlagSubresourceModel{
+		ID:      types.Int64Null(),
+		Type:    FlagTypeStatic,
+		Content: types.StringValue(content),
+		Case:    FlagCaseInsensitive,
+		Data:    types.StringValue(""),
+	}
+	if id != 0 {
+		flag.ID = types.Int64Value(id)
+	}
+	return flag
+}
+
+func Test_U_CreatePartialFailure(t *testing.T) {
+	ctx := context.Background()
+	r := &challengeResource{client: newPartialFailureServer(t)}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	plan := tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	if diags := plan.Set(ctx, testChallenge(testFlag(0, "a"), testFlag(0, "fail"))); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+	}
+
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected the creation of a flag to fail")
+	}
+	var state ChallengeResourceModel
+	if diags := resp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if state.ID.ValueString() != "1" {
+		t.Fatalf("expected the challenge to be tracked, got ID %s", state.ID)
+	}
+	if len(state.Flags) != 1 || state.Flags[0].ID.ValueInt64() != 10 {
+		t.Fatalf("expected the created flag to be tracked, got %v", state.Flags)
+	}
+}
+
+func Test_U_UpdatePartialFailure(t *testing.T) {
+	ctx := context.Background()
+	r := &challengeResource{client: newPartialFailureServer(t)}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	prior := testChallenge(testFlag(1, "a"), testFlag(2, "x"))
+	prior.ID = types.StringValue("1")
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	if diags := state.Set(ctx, prior); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	// Flag 1 is patched, flag 2 fails to, "b" is created and "fail" fails to
+	planned := testChallenge(testFlag(0, "a2"), testFlag(0, "x2"), testFlag(0, "b"), testFlag(0, "fail"))
+	planned.ID = types.StringValue("1")
+	plan := tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	if diags := plan.Set(ctx, planned); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	// As the framework does, the response starts from the prior state
+	resp := &resource.UpdateResponse{State: state}
+
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+
+	if len(resp.Diagnostics.Errors()) != 2 {
+		t.Fatalf("expected 2 errors, got %v", resp.Diagnostics)
+	}
+	var synced ChallengeResourceModel
+	if diags := resp.State.Get(ctx, &synced); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	expected := map[int64]string{1: "a2", 2: "x", 10: "b"}
+	if len(synced.Flags) != len(expected) {
+		t.Fatalf("expected flags %v, got %v", expected, synced.Flags)
+	}
+	for _, flag := range synced.Flags {
+		if expected[flag.ID.ValueInt64()] != flag.Content.ValueString() {
+			t.Fatalf("expected flags %v, got %v", expected, synced.Flags)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package challenge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ctfer-io/go-ctfd/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/utils"
+)
+
+var (
+	_ datasource.DataSource              = (*challengeDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*challengeDataSource)(nil)
+)
+
+func NewChallengeDataSource() datasource.DataSource {
+	return &challengeDataSource{}
+}
+
+type challengeDataSource struct {
+	client *utils.Client
+}
+
+func (ch *challengeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_challenge"
+}
+
+func (ch *challengeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Look up a single challenge, whatever its type, either by its `id` or by its `name` (and optionally `category`). Useful to reference challenges that are not managed by the same Terraform configuration, e.g. in `requirements.prerequisites`.",
+		Attributes:          challengeDataSourceAttributes(true),
+	}
+}
+
+func (ch *challengeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*utils.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *github.com/AlexEreh/terraform-provider-ctfd/provider/utils.Client, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", req.ProviderData),
+		)
+		return
+	}
+
+	ch.client = client
+}
+
+func (ch *challengeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_challenge", "Read")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	var config challengeDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var id int
+	switch {
+	case !config.ID.IsNull():
+		id = utils.Atoi(config.ID.ValueString())
+
+	case !config.Name.IsNull():
+		// CTFd challenges list does not support filtering by category name,
+		// so this filter is applied afterward.
+		challs, err := ch.client.GetChallenges(&api.GetChallengesParams{
+			Name: config.Name.ValueStringPointer(),
+			View: utils.Ptr("admin"),
+		}, ch.client.Options(ctx)...)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read CTFd Challenges",
+				err.Error(),
+			)
+			return
+		}
+
+		matches := []int{}
+		for _, c := range challs {
+			if c.Name != config.Name.ValueString() {
+				continue
+			}
+			if !config.Category.IsNull() && c.Category != config.Category.ValueString() {
+				continue
+			}
+			matches = append(matches, c.ID)
+		}
+		switch len(matches) {
+		case 0:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Challenge Not Found",
+				fmt.Sprintf("No challenge matches name %q%s.", config.Name.ValueString(), categorySuffix(config)),
+			)
+			return
+		case 1:
+			id = matches[0]
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Multiple Challenges Found",
+				fmt.Sprintf("%d challenges match name %q%s, consider setting the category or the id.", len(matches), config.Name.ValueString(), categorySuffix(config)),
+			)
+			return
+		}
+
+	default:
+		resp.Diagnostics.AddError(
+			"Invalid Challenge Lookup",
+			"Either id or name must be set to look up a challenge.",
+		)
+		return
+	}
+
+	state, diags := readChallengeDataSource(ctx, ch.client, id)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func categorySuffix(config challengeDataSourceModel) string {
+	if config.Category.IsNull() {
+		return ""
+	}
+	return fmt.Sprintf(" in category %q", config.Category.ValueString())
+}
@@ -0,0 +1,136 @@
+package challenge
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/ctfer-io/go-ctfd/api"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/utils"
+)
+
+// SyncChallengeTags reconciles the tags of a challenge with the desired
+// ones, only creating the missing ones and deleting the extra ones.
+func SyncChallengeTags(ctx context.Context, client *utils.Client, challengeID int, desired []types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	current, err := client.GetChallengeTags(challengeID, client.Options(ctx)...)
+	if err != nil {
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to get all tags of challenge %d, got error: %s", challengeID, err),
+		)
+		return diags
+	}
+	currentIDs := make([]int, 0, len(current))
+	currentValues := make([]string, 0, len(current))
+	for _, tag := range current {
+		currentIDs = append(currentIDs, tag.ID)
+		currentValues = append(currentValues, tag.Value)
+	}
+
+	toCreate, toDelete := diffValues(desired, currentIDs, currentValues)
+	for _, id := range toDelete {
+		if err := client.DeleteTag(strconv.Itoa(id), client.Options(ctx)...); err != nil {
+			diags.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to delete tag %d of challenge %d, got error: %s", id, challengeID, err),
+			)
+			return diags
+		}
+	}
+	for _, value := range toCreate {
+		if _, err := client.PostTags(&api.PostTagsParams{
+			Challenge: challengeID,
+			Value:     value,
+		}, client.Options(ctx)...); err != nil {
+			diags.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to create tag of challenge %d, got error: %s", challengeID, err),
+			)
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// SyncChallengeTopics reconciles the topics of a challenge with the
+// desired ones, only creating the missing ones and deleting the extra
+// ones.
+func SyncChallengeTopics(ctx context.Context, client *utils.Client, challengeID int, desired []types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	current, err := client.GetChallengeTopics(challengeID, client.Options(ctx)...)
+	if err != nil {
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to get all topics of challenge %d, got error: %s", challengeID, err),
+		)
+		return diags
+	}
+	currentIDs := make([]int, 0, len(current))
+	currentValues := make([]string, 0, len(current))
+	for _, topic := range current {
+		currentIDs = append(currentIDs, topic.ID)
+		currentValues = append(currentValues, topic.Value)
+	}
+
+	toCreate, toDelete := diffValues(desired, currentIDs, currentValues)
+	for _, id := range toDelete {
+		if err := client.DeleteTopic(&api.DeleteTopicArgs{
+			ID:   strconv.Itoa(id),
+			Type: "challenge",
+		}, client.Options(ctx)...); err != nil {
+			diags.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to delete topic %d of challenge %d, got error: %s", id, challengeID, err),
+			)
+			return diags
+		}
+	}
+	for _, value := range toCreate {
+		if _, err := client.PostTopics(&api.PostTopicsParams{
+			Challenge: challengeID,
+			Type:      "challenge",
+			Value:     value,
+		}, client.Options(ctx)...); err != nil {
+			diags.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to create topic of challenge %d, got error: %s", challengeID, err),
+			)
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// diffValues returns the desired values missing from the current ones,
+// and the IDs of the current ones that are not desired (including
+// duplicates).
+func diffValues(desired []types.String, currentIDs []int, currentValues []string) (toCreate []string, toDelete []int) {
+	want := make(map[string]bool, len(desired))
+	for _, value := range desired {
+		want[value.ValueString()] = true
+	}
+
+	seen := make(map[string]bool, len(currentValues))
+	for i, value := range currentValues {
+		if want[value] && !seen[value] {
+			seen[value] = true
+			continue
+		}
+		toDelete = append(toDelete, currentIDs[i])
+	}
+	for _, value := range desired {
+		if !seen[value.ValueString()] {
+			seen[value.ValueString()] = true
+			toCreate = append(toCreate, value.ValueString())
+		}
+	}
+	return toCreate, toDelete
+}
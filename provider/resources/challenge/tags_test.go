@@ -0,0 +1,78 @@
+package challenge
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func Test_U_DiffValues(t *testing.T) {
+	var tests = map[string]struct {
+		Desired        []string
+		CurrentIDs     []int
+		CurrentValues  []string
+		ExpectToCreate []string
+		ExpectToDelete []int
+	}{
+		"empty": {},
+		"create-all": {
+			Desired:        []string{"web", "easy"},
+			ExpectToCreate: []string{"web", "easy"},
+		},
+		"delete-all": {
+			CurrentIDs:     []int{1, 2},
+			CurrentValues:  []string{"web", "easy"},
+			ExpectToDelete: []int{1, 2},
+		},
+		"unchanged": {
+			Desired:       []string{"web", "easy"},
+			CurrentIDs:    []int{1, 2},
+			CurrentValues: []string{"easy", "web"},
+		},
+		"incremental": {
+			Desired:        []string{"web", "hard"},
+			CurrentIDs:     []int{1, 2},
+			CurrentValues:  []string{"web", "easy"},
+			ExpectToCreate: []string{"hard"},
+			ExpectToDelete: []int{2},
+		},
+		"current-duplicates": {
+			Desired:        []string{"web"},
+			CurrentIDs:     []int{1, 2, 3},
+			CurrentValues:  []string{"web", "web", "web"},
+			ExpectToDelete: []int{2, 3},
+		},
+		"desired-duplicates": {
+			Desired:        []string{"web", "web", "easy"},
+			CurrentIDs:     []int{1},
+			CurrentValues:  []string{"easy"},
+			ExpectToCreate: []string{"web"},
+		},
+		"case-sensitive": {
+			Desired:        []string{"Web"},
+			CurrentIDs:     []int{1},
+			CurrentValues:  []string{"web"},
+			ExpectToCreate: []string{"Web"},
+			ExpectToDelete: []int{1},
+		},
+	}
+
+	for testname, tt := range tests {
+		t.Run(testname, func(t *testing.T) {
+			desired := make([]types.String, 0, len(tt.Desired))
+			for _, value := range tt.Desired {
+				desired = append(desired, types.StringValue(value))
+			}
+
+			toCreate, toDelete := diffValues(desired, tt.CurrentIDs, tt.CurrentValues)
+
+			if !reflect.DeepEqual(toCreate, tt.ExpectToCreate) {
+				t.Errorf("expected to create %v, got %v", tt.ExpectToCreate, toCreate)
+			}
+			if !reflect.DeepEqual(toDelete, tt.ExpectToDelete) {
+				t.Errorf("expected to delete %v, got %v", tt.ExpectToDelete, toDelete)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package challenge
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// UpgradeState upgrades the prior versions of the ctfd_challenge state.
+//   - 0 -> 1: the requirements prerequisites turned from strings to
+//     numbers.
+func (r *challengeResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				resp.Diagnostics.Append(convertRawState(ctx, req.RawState, &resp.State, prerequisitesToNumbers)...)
+			},
+		},
+	}
+}
@@ -0,0 +1,584 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/utils"
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/validators"
+)
+
+var (
+	_ resource.Resource                = (*configResource)(nil)
+	_ resource.ResourceWithConfigure   = (*configResource)(nil)
+	_ resource.ResourceWithImportState = (*configResource)(nil)
+)
+
+func NewConfigResource() resource.Resource {
+	return &configResource{}
+}
+
+type configResource struct {
+	client *utils.Client
+}
+
+type configResourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	ResetOnDelete          types.Bool   `tfsdk:"reset_on_delete"`
+	CTFName                types.String `tfsdk:"ctf_name"`
+	CTFDescription         types.String `tfsdk:"ctf_description"`
+	UserMode               types.String `tfsdk:"user_mode"`
+	Start                  types.String `tfsdk:"start"`
+	End                    types.String `tfsdk:"end"`
+	Freeze                 types.String `tfsdk:"freeze"`
+	ViewAfterCTF           types.Bool   `tfsdk:"view_after_ctf"`
+	Paused                 types.Bool   `tfsdk:"paused"`
+	ChallengeVisibility    types.String `tfsdk:"challenge_visibility"`
+	AccountVisibility      types.String `tfsdk:"account_visibility"`
+	ScoreVisibility        types.String `tfsdk:"score_visibility"`
+	RegistrationVisibility types.String `tfsdk:"registration_visibility"`
+	RegistrationCode       types.String `tfsdk:"registration_code"`
+	VerifyEmails           types.Bool   `tfsdk:"verify_emails"`
+	NameChanges            types.Bool   `tfsdk:"name_changes"`
+	TeamCreation           types.Bool   `tfsdk:"team_creation"`
+	TeamSize               types.Int64  `tfsdk:"team_size"`
+	NumTeams               types.Int64  `tfsdk:"num_teams"`
+	NumUsers               types.Int64  `tfsdk:"num_users"`
+	CTFTheme               types.String `tfsdk:"ctf_theme"`
+	ThemeHeader            types.String `tfsdk:"theme_header"`
+	ThemeFooter            types.String `tfsdk:"theme_footer"`
+	MailServer             types.String `tfsdk:"mail_server"`
+	MailPort               types.Int64  `tfsdk:"mail_port"`
+	MailUseAuth            types.Bool   `tfsdk:"mail_useauth"`
+	MailUsername           types.String `tfsdk:"mail_username"`
+	MailPassword           types.String `tfsdk:"mail_password"`
+	MailTLS                types.Bool   `tfsdk:"mail_tls"`
+	MailSSL                types.Bool   `tfsdk:"mail_ssl"`
+	MailFromAddr           types.String `tfsdk:"mailfrom_addr"`
+}
+
+func (r *configResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config"
+}
+
+func (r *configResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	enum := func(values ...string) validator.String {
+		vals := make([]basetypes.StringValue, 0, len(values))
+		for _, v := range values {
+			vals = append(vals, types.StringValue(v))
+		}
+		return validators.NewStringEnumValidator(vals)
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The CTF-wide settings of the CTFd instance. It is a singleton, so declare it only once.\n\nOnly the configured settings are managed: the others are left as is, and are not read back. On import, all the settings set in CTFd are managed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the configuration, always `config`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reset_on_delete": schema.BoolAttribute{
+				MarkdownDescription: "If true, the managed settings are reset to their CTFd default on destroy. Else destroy is a no-op.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"ctf_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the CTF.",
+				Optional:            true,
+			},
+			"ctf_description": schema.StringAttribute{
+				MarkdownDescription: "Description of the CTF.",
+				Optional:            true,
+			},
+			"user_mode": schema.StringAttribute{
+				MarkdownDescription: "Whether players compete as users or teams. Changing it on a running CTF may lead to inconsistencies.",
+				Optional:            true,
+				Validators: []validator.String{
+					enum("users", "teams"),
+				},
+			},
+			"start": schema.StringAttribute{
+				MarkdownDescription: "Start of the CTF, as an RFC3339 timestamp.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.NewRFC3339Validator(),
+				},
+			},
+			"end": schema.StringAttribute{
+				MarkdownDescription: "End of the CTF, as an RFC3339 timestamp.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.NewRFC3339Validator(),
+				},
+			},
+			"freeze": schema.StringAttribute{
+				MarkdownDescription: "Freeze time of the scoreboard, as an RFC3339 timestamp.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.NewRFC3339Validator(),
+				},
+			},
+			"view_after_ctf": schema.BoolAttribute{
+				MarkdownDescription: "Whether challenges could still be viewed and solved (without scoring) after the end of the CTF.",
+				Optional:            true,
+			},
+			"paused": schema.BoolAttribute{
+				MarkdownDescription: "Whether the CTF is paused.",
+				Optional:            true,
+			},
+			"challenge_visibility": schema.StringAttribute{
+				MarkdownDescription: "Who can see the challenges, either public, private or admins.",
+				Optional:            true,
+				Validators: []validator.String{
+					enum("public", "private", "admins"),
+				},
+			},
+			"account_visibility": schema.StringAttribute{
+				MarkdownDescription: "Who can see the accounts, either public, private or admins.",
+				Optional:            true,
+				Validators: []validator.String{
+					enum("public", "private", "admins"),
+				},
+			},
+			"score_visibility": schema.StringAttribute{
+				MarkdownDescription: "Who can see the scores, either public, private, hidden or admins.",
+				Optional:            true,
+				Validators: []validator.String{
+					enum("public", "private", "hidden", "admins"),
+				},
+			},
+			"registration_visibility": schema.StringAttribute{
+				MarkdownDescription: "Who can register, either public, private or mlc (MajorLeagueCyber).",
+				Optional:            true,
+				Validators: []validator.String{
+					enum("public", "private", "mlc"),
+				},
+			},
+			"registration_code": schema.StringAttribute{
+				MarkdownDescription: "Code required to register.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"verify_emails": schema.BoolAttribute{
+				MarkdownDescription: "Whether users must verify their email.",
+				Optional:            true,
+			},
+			"name_changes": schema.BoolAttribute{
+				MarkdownDescription: "Whether users and teams can change their name.",
+				Optional:            true,
+			},
+			"team_creation": schema.BoolAttribute{
+				MarkdownDescription: "Whether users can create teams.",
+				Optional:            true,
+			},
+			"team_size": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of members per team (0 for unlimited).",
+				Optional:            true,
+			},
+			"num_teams": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of teams (0 for unlimited).",
+				Optional:            true,
+			},
+			"num_users": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of users (0 for unlimited).",
+				Optional:            true,
+			},
+			"ctf_theme": schema.StringAttribute{
+				MarkdownDescription: "Theme of the CTFd instance (e.g. core).",
+				Optional:            true,
+			},
+			"theme_header": schema.StringAttribute{
+				MarkdownDescription: "Custom HTML injected in the header of the pages.",
+				Optional:            true,
+			},
+			"theme_footer": schema.StringAttribute{
+				MarkdownDescription: "Custom HTML injected in the footer of the pages.",
+				Optional:            true,
+			},
+			"mail_server": schema.StringAttribute{
+				MarkdownDescription: "SMTP server to send emails with.",
+				Optional:            true,
+			},
+			"mail_port": schema.Int64Attribute{
+				MarkdownDescription: "Port of the SMTP server.",
+				Optional:            true,
+			},
+			"mail_useauth": schema.BoolAttribute{
+				MarkdownDescription: "Whether to authenticate against the SMTP server.",
+				Optional:            true,
+			},
+			"mail_username": schema.StringAttribute{
+				MarkdownDescription: "Username to authenticate against the SMTP server.",
+				Optional:            true,
+			},
+			"mail_password": schema.StringAttribute{
+				MarkdownDescription: "Password to authenticate against the SMTP server.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"mail_tls": schema.BoolAttribute{
+				MarkdownDescription: "Whether to use TLS with the SMTP server.",
+				Optional:            true,
+			},
+			"mail_ssl": schema.BoolAttribute{
+				MarkdownDescription: "Whether to use SSL with the SMTP server.",
+				Optional:            true,
+			},
+			"mailfrom_addr": schema.StringAttribute{
+				MarkdownDescription: "Email address the emails are sent from.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *configResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*utils.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *github.com/AlexEreh/terraform-provider-ctfd/provider/utils.Client, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *configResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_config", "Create")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	var data configResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.patch(ctx, r.client)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "configured the CTF")
+
+	// Save computed attributes in state
+	data.ID = types.StringValue("config")
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *configResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_config", "Read")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	var data configResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	values, err := r.values(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read configs, got error: %s", err),
+		)
+		return
+	}
+
+	// Only refresh the managed settings
+	for _, f := range data.fields() {
+		f.read(values)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *configResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_config", "Update")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	var data configResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.patch(ctx, r.client)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *configResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_config", "Delete")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	var data configResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ResetOnDelete.ValueBool() {
+		return
+	}
+
+	// Deleting a config makes CTFd fallback to its default
+	for _, f := range data.fields() {
+		if f.isNull() {
+			continue
+		}
+		if err := r.client.DeleteConfigsByKey(f.key, r.client.Options(ctx)...); err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to reset config %s, got error: %s", f.key, err),
+			)
+			return
+		}
+	}
+}
+
+func (r *configResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_config", "ImportState")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	values, err := r.values(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read configs, got error: %s", err),
+		)
+		return
+	}
+
+	// Nothing is managed yet, so manage all the settings set in CTFd
+	// for r.Read to refresh them
+	data := configResourceModel{
+		ID:            types.StringValue("config"),
+		ResetOnDelete: types.BoolValue(false),
+	}
+	for _, f := range data.fields() {
+		if _, ok := values[f.key]; ok {
+			f.manage()
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Automatically call r.Read
+}
+
+// values returns the CTFd config values by their key.
+func (r *configResource) values(ctx context.Context) (map[string]string, error) {
+	configs, err := r.client.GetConfigs(nil, r.client.Options(ctx)...)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]string, len(configs))
+	for _, c := range configs {
+		values[c.Key] = c.Value
+	}
+	return values, nil
+}
+
+//
+// Starting from this are helper or types-specific code related to the ctfd_config resource
+//
+
+// configField binds a CTFd config key to its attribute in the model.
+// Only one of the values is set.
+type configField struct {
+	key   string
+	str   *types.String
+	num   *types.Int64
+	bool  *types.Bool
+	epoch *types.String // RFC3339 in the model, UNIX timestamp in CTFd
+}
+
+func (data *configResourceModel) fields() []configField {
+	return []configField{
+		{key: "ctf_name", str: &data.CTFName},
+		{key: "ctf_description", str: &data.CTFDescription},
+		{key: "user_mode", str: &data.UserMode},
+		{key: "start", epoch: &data.Start},
+		{key: "end", epoch: &data.End},
+		{key: "freeze", epoch: &data.Freeze},
+		{key: "view_after_ctf", bool: &data.ViewAfterCTF},
+		{key: "paused", bool: &data.Paused},
+		{key: "challenge_visibility", str: &data.ChallengeVisibility},
+		{key: "account_visibility", str: &data.AccountVisibility},
+		{key: "score_visibility", str: &data.ScoreVisibility},
+		{key: "registration_visibility", str: &data.RegistrationVisibility},
+		{key: "registration_code", str: &data.RegistrationCode},
+		{key: "verify_emails", bool: &data.VerifyEmails},
+		{key: "name_changes", bool: &data.NameChanges},
+		{key: "team_creation", bool: &data.TeamCreation},
+		{key: "team_size", num: &data.TeamSize},
+		{key: "num_teams", num: &data.NumTeams},
+		{key: "num_users", num: &data.NumUsers},
+		{key: "ctf_theme", str: &data.CTFTheme},
+		{key: "theme_header", str: &data.ThemeHeader},
+		{key: "theme_footer", str: &data.ThemeFooter},
+		{key: "mail_server", str: &data.MailServer},
+		{key: "mail_port", num: &data.MailPort},
+		{key: "mail_useauth", bool: &data.MailUseAuth},
+		{key: "mail_username", str: &data.MailUsername},
+		{key: "mail_password", str: &data.MailPassword},
+		{key: "mail_tls", bool: &data.MailTLS},
+		{key: "mail_ssl", bool: &data.MailSSL},
+		{key: "mailfrom_addr", str: &data.MailFromAddr},
+	}
+}
+
+// patch sends the configured settings to CTFd.
+// It does not use api.PatchConfigsParams as some of its fields are not
+// omitted when empty, which would overwrite the settings not managed here.
+func (data *configResourceModel) patch(ctx context.Context, client *utils.Client) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	params := map[string]any{}
+	for _, f := range data.fields() {
+		if f.isNull() {
+			continue
+		}
+		v, err := f.value()
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root(f.key),
+				"Invalid Config Value",
+				err.Error(),
+			)
+			continue
+		}
+		params[f.key] = v
+	}
+	if diags.HasError() || len(params) == 0 {
+		return diags
+	}
+
+	if err := client.Patch("/configs", params, nil, client.Options(ctx)...); err != nil {
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to update configs, got error: %s", err),
+		)
+	}
+	return diags
+}
+
+func (f configField) isNull() bool {
+	switch {
+	case f.str != nil:
+		return f.str.IsNull()
+	case f.num != nil:
+		return f.num.IsNull()
+	case f.bool != nil:
+		return f.bool.IsNull()
+	default:
+		return f.epoch.IsNull()
+	}
+}
+
+func (f configField) value() (any, error) {
+	switch {
+	case f.str != nil:
+		return f.str.ValueString(), nil
+	case f.num != nil:
+		return f.num.ValueInt64(), nil
+	case f.bool != nil:
+		return f.bool.ValueBool(), nil
+	default:
+		t, err := time.Parse(time.RFC3339, f.epoch.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		return t.Unix(), nil
+	}
+}
+
+// manage sets the attribute to its zero value, such that it is read.
+func (f configField) manage() {
+	switch {
+	case f.str != nil:
+		*f.str = types.StringValue("")
+	case f.num != nil:
+		*f.num = types.Int64Value(0)
+	case f.bool != nil:
+		*f.bool = types.BoolValue(false)
+	default:
+		*f.epoch = types.StringValue("")
+	}
+}
+
+// read refreshes the attribute from the CTFd config values, if it is managed.
+func (f configField) read(values map[string]string) {
+	if f.isNull() {
+		return
+	}
+	v, ok := values[f.key]
+	switch {
+	case f.str != nil:
+		*f.str = types.StringValue(v)
+
+	case f.num != nil:
+		i, err := strconv.ParseInt(v, 10, 64)
+		if !ok || err != nil {
+			*f.num = types.Int64Value(0)
+			return
+		}
+		*f.num = types.Int64Value(i)
+
+	case f.bool != nil:
+		switch strings.ToLower(v) {
+		case "1", "true", "y", "yes", "on":
+			*f.bool = types.BoolValue(true)
+		default:
+			*f.bool = types.BoolValue(false)
+		}
+
+	default:
+		i, err := strconv.ParseInt(v, 10, 64)
+		if !ok || err != nil {
+			*f.epoch = types.StringValue("")
+			return
+		}
+		// Keep the configured representation (e.g. timezone) if it is the same instant
+		if prior, err := time.Parse(time.RFC3339, f.epoch.ValueString()); err == nil && prior.Unix() == i {
+			return
+		}
+		*f.epoch = types.StringValue(time.Unix(i, 0).UTC().Format(time.RFC3339))
+	}
+}
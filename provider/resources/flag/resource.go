@@ -0,0 +1,296 @@
+package flag
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/ctfer-io/go-ctfd/api"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/resources/challenge"
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/utils"
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/validators"
+)
+
+var (
+	_ resource.Resource                   = (*flagResource)(nil)
+	_ resource.ResourceWithConfigure      = (*flagResource)(nil)
+	_ resource.ResourceWithImportState    = (*flagResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*flagResource)(nil)
+)
+
+func NewFlagResource() resource.Resource {
+	return &flagResource{}
+}
+
+type flagResource struct {
+	client *utils.Client
+}
+
+type flagResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	ChallengeID types.String `tfsdk:"challenge_id"`
+	Type        types.String `tfsdk:"type"`
+	Content     types.String `tfsdk:"content"`
+	Case        types.String `tfsdk:"case"`
+	Data        types.String `tfsdk:"data"`
+	TestInputs  types.List   `tfsdk:"test_inputs"`
+}
+
+// flag returns the model as an inline flag of a challenge, such that
+// both share their mapping to CTFd.
+func (data flagResourceModel) flag() challenge.FlagSubresourceModel {
+	return challenge.FlagSubresourceModel{
+		Type:    data.Type,
+		Content: data.Content,
+		Case:    data.Case,
+		Data:    data.Data,
+	}
+}
+
+// fromAPIFlag updates the model with a CTFd flag.
+func (data *flagResourceModel) fromAPIFlag(flag *api.Flag) {
+	model := challenge.FromAPIFlag(flag, data.flag())
+
+	challID := flag.ChallengeID
+	if challID == 0 {
+		challID = flag.Challenge
+	}
+	data.ChallengeID = types.StringValue(strconv.Itoa(challID))
+	data.Type = model.Type
+	data.Content = model.Content
+	data.Case = model.Case
+	data.Data = model.Data
+}
+
+func (r *flagResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_flag"
+}
+
+func (r *flagResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A flag to solve a challenge, with its own lifecycle. Do not use it on a challenge that defines its `flags` inline.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the flag, used internally to handle the CTFd corresponding object.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"challenge_id": schema.StringAttribute{
+				MarkdownDescription: "Challenge of the flag.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the flag (static, regex, programmable).",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(challenge.FlagTypeStatic.ValueString()),
+				Validators: []validator.String{
+					validators.NewStringEnumValidator([]basetypes.StringValue{
+						challenge.FlagTypeStatic,
+						challenge.FlagTypeRegex,
+						challenge.FlagTypeProgrammable,
+					}),
+				},
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "Flag content.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"case": schema.StringAttribute{
+				MarkdownDescription: "Case-sensitivity behavior of static and regex flags, either case_insensitive or case_sensitive. It is stored by CTFd as the data of the flag.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(challenge.FlagCaseInsensitive.ValueString()),
+				Validators: []validator.String{
+					validators.NewStringEnumValidator([]basetypes.StringValue{
+						challenge.FlagCaseInsensitive,
+						challenge.FlagCaseSensitive,
+					}),
+				},
+			},
+			"data": schema.StringAttribute{
+				MarkdownDescription: "Additional data of the flag, as interpreted by its type. Ignored by static and regex flags, which use `case` instead.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"test_inputs": schema.ListAttribute{
+				MarkdownDescription: "Submissions that must match a regex flag, checked at plan time against the regex as CTFd would (Python dialect, whole submission, with respect to `case`). They are not sent to CTFd.",
+				Optional:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *flagResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var flagCase types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("case"), &flagCase)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	caseInsensitive := types.BoolUnknown()
+	if !flagCase.IsUnknown() {
+		caseInsensitive = types.BoolValue(!flagCase.Equal(challenge.FlagCaseSensitive))
+	}
+	resp.Diagnostics.Append(challenge.ValidateFlagConfig(ctx, req.Config, path.Empty(), caseInsensitive)...)
+}
+
+func (r *flagResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*utils.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *github.com/AlexEreh/terraform-provider-ctfd/provider/utils.Client, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *flagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_flag", "Create")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	var data flagResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create flag
+	res, err := r.client.PostFlags(&api.PostFlagsParams{
+		Challenge: utils.Atoi(data.ChallengeID.ValueString()),
+		Content:   data.Content.ValueString(),
+		Data:      challenge.FlagData(data.flag()),
+		Type:      data.Type.ValueString(),
+	}, r.client.Options(ctx)...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create flag of challenge %s, got error: %s", data.ChallengeID.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "created a flag")
+
+	// Save computed attributes in state
+	data.ID = types.StringValue(strconv.Itoa(res.ID))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *flagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_flag", "Read")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	var data flagResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve flag
+	res, err := r.client.GetFlag(data.ID.ValueString(), r.client.Options(ctx)...)
+	if err != nil {
+		if utils.IsNotFound(err) {
+			// Deleted outside of Terraform, plan its re-creation
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read flag %s, got error: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	// Upsert values
+	data.fromAPIFlag(res)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *flagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_flag", "Update")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	var data flagResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update flag
+	if _, err := r.client.PatchFlag(data.ID.ValueString(), &api.PatchFlagParams{
+		Content: data.Content.ValueString(),
+		Data:    challenge.FlagData(data.flag()),
+		ID:      data.ID.ValueString(),
+		Type:    data.Type.ValueString(),
+	}, r.client.Options(ctx)...); err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to update flag %s, got error: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *flagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_flag", "Delete")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	var data flagResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteFlag(data.ID.ValueString(), r.client.Options(ctx)...); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete flag %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+}
+
+func (r *flagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	// Automatically call r.Read
+}
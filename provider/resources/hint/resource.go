@@ -0,0 +1,261 @@
+package hint
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/ctfer-io/go-ctfd/api"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/utils"
+)
+
+var (
+	_ resource.Resource                = (*hintResource)(nil)
+	_ resource.ResourceWithConfigure   = (*hintResource)(nil)
+	_ resource.ResourceWithImportState = (*hintResource)(nil)
+)
+
+func NewHintResource() resource.Resource {
+	return &hintResource{}
+}
+
+type hintResource struct {
+	client *utils.Client
+}
+
+type hintResourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	ChallengeID  types.String   `tfsdk:"challenge_id"`
+	Title        types.String   `tfsdk:"title"`
+	Content      types.String   `tfsdk:"content"`
+	Cost         types.Int64    `tfsdk:"cost"`
+	Requirements []types.String `tfsdk:"requirements"`
+}
+
+func (r *hintResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hint"
+}
+
+func (r *hintResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A hint for a challenge to help players solve it, eventually at a given cost.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the hint, used internally to handle the CTFd corresponding object.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"challenge_id": schema.StringAttribute{
+				MarkdownDescription: "Challenge of the hint.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Title of the hint, displayed to the end-user before unlocking it.",
+				Optional:            true,
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "Content of the hint as displayed to the end-user once unlocked.",
+				Required:            true,
+			},
+			"cost": schema.Int64Attribute{
+				MarkdownDescription: "Cost of the hint (points removed once unlocked).",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+			},
+			"requirements": schema.ListAttribute{
+				MarkdownDescription: "List of the other hints ID that needs to be unlocked before this one.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(basetypes.NewListValueMust(types.StringType, []attr.Value{})),
+			},
+		},
+	}
+}
+
+func (r *hintResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*utils.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *github.com/AlexEreh/terraform-provider-ctfd/provider/utils.Client, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *hintResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_hint", "Create")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	var data hintResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create hint
+	res, err := r.client.PostHints(&api.PostHintsParams{
+		ChallengeID: utils.Atoi(data.ChallengeID.ValueString()),
+		Title:       data.Title.ValueStringPointer(),
+		Content:     data.Content.ValueString(),
+		Cost:        int(data.Cost.ValueInt64()),
+		Requirements: api.Requirements{
+			Prerequisites: toPrerequisites(data.Requirements),
+		},
+	}, r.client.Options(ctx)...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create hint of challenge %s, got error: %s", data.ChallengeID.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "created a hint")
+
+	// Save computed attributes in state
+	data.ID = types.StringValue(strconv.Itoa(res.ID))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *hintResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_hint", "Read")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	var data hintResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve hint, content is only returned to admins previewing it
+	res, err := r.client.GetHint(data.ID.ValueString(), &api.GetHintParams{
+		Preview: utils.Ptr(true),
+	}, r.client.Options(ctx)...)
+	if err != nil {
+		if utils.IsNotFound(err) {
+			// Deleted outside of Terraform, plan its re-creation
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read hint %s, got error: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	// Upsert values
+	data.ChallengeID = types.StringValue(strconv.Itoa(res.ChallengeID))
+	data.Title = utils.ToTFString(res.Title)
+	if res.Content != nil {
+		data.Content = types.StringValue(*res.Content)
+	}
+	data.Cost = types.Int64Value(int64(res.Cost))
+	data.Requirements = []types.String{}
+	if res.Requirements != nil {
+		for _, preq := range res.Requirements.Prerequisites {
+			data.Requirements = append(data.Requirements, types.StringValue(strconv.Itoa(preq)))
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *hintResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_hint", "Update")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	var data hintResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update hint
+	if _, err := r.client.PatchHint(data.ID.ValueString(), &api.PatchHintsParams{
+		ChallengeID: utils.Atoi(data.ChallengeID.ValueString()),
+		Title:       data.Title.ValueStringPointer(),
+		Content:     data.Content.ValueString(),
+		Cost:        int(data.Cost.ValueInt64()),
+		Requirements: api.Requirements{
+			Prerequisites: toPrerequisites(data.Requirements),
+		},
+	}, r.client.Options(ctx)...); err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to update hint %s, got error: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *hintResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_hint", "Delete")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	var data hintResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteHint(data.ID.ValueString(), r.client.Options(ctx)...); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete hint %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+}
+
+func (r *hintResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	// Automatically call r.Read
+}
+
+// toPrerequisites converts the hints ID into CTFd's requirements
+// prerequisites.
+func toPrerequisites(reqs []types.String) []int {
+	preqs := make([]int, 0, len(reqs))
+	for _, req := range reqs {
+		preqs = append(preqs, utils.Atoi(req.ValueString()))
+	}
+	return preqs
+}
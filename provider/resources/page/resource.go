@@ -0,0 +1,316 @@
+package page
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/ctfer-io/go-ctfd/api"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/utils"
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/validators"
+)
+
+var (
+	_ resource.Resource                = (*pageResource)(nil)
+	_ resource.ResourceWithConfigure   = (*pageResource)(nil)
+	_ resource.ResourceWithImportState = (*pageResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*pageResource)(nil)
+)
+
+func NewPageResource() resource.Resource {
+	return &pageResource{}
+}
+
+type pageResource struct {
+	client *utils.Client
+}
+
+type pageResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Route        types.String `tfsdk:"route"`
+	Title        types.String `tfsdk:"title"`
+	Content      types.String `tfsdk:"content"`
+	Format       types.String `tfsdk:"format"`
+	Draft        types.Bool   `tfsdk:"draft"`
+	Hidden       types.Bool   `tfsdk:"hidden"`
+	AuthRequired types.Bool   `tfsdk:"auth_required"`
+	// Files attached to the page, e.g. images to display in its content.
+	Files []utils.FileSubresourceModel `tfsdk:"files"`
+}
+
+func (r *pageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_page"
+}
+
+func (r *pageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A custom page of the CTFd instance, e.g. the rules, the sponsors or the index.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the page.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"route": schema.StringAttribute{
+				MarkdownDescription: "Route of the page (e.g. `rules`), the index page uses `index`.",
+				Required:            true,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Title of the page.",
+				Required:            true,
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "Content of the page, interpreted according to its format. Attached files are served under their `url`.",
+				Required:            true,
+			},
+			"format": schema.StringAttribute{
+				MarkdownDescription: "Format of the content, either markdown or html.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("markdown"),
+				Validators: []validator.String{
+					validators.NewStringEnumValidator([]basetypes.StringValue{
+						types.StringValue("markdown"),
+						types.StringValue("html"),
+					}),
+				},
+			},
+			"draft": schema.BoolAttribute{
+				MarkdownDescription: "Is true if the page is a draft, thus not published.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"hidden": schema.BoolAttribute{
+				MarkdownDescription: "Is true if the page is hidden from the navigation bar (still reachable by its route).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"auth_required": schema.BoolAttribute{
+				MarkdownDescription: "Is true if the page requires the end-user to be authenticated.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"files": schema.ListNestedAttribute{
+				MarkdownDescription: "List of files (e.g. images) attached to this page, to use in its content through their `url`.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: utils.FileAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (r *pageResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*utils.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *github.com/AlexEreh/terraform-provider-ctfd/provider/utils.Client, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *pageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(utils.PlanFileDigests(ctx, &resp.Plan, path.Root("files"))...)
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(utils.PlanUnchangedFiles(ctx, req.State, &resp.Plan, path.Root("files"))...)
+	}
+}
+
+func (r *pageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_page", "Create")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	var data pageResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.PostPages(&api.PostPagesParams{
+		AuthRequired: data.AuthRequired.ValueBool(),
+		Content:      data.Content.ValueString(),
+		Draft:        data.Draft.ValueBool(),
+		Format:       data.Format.ValueString(),
+		Hidden:       data.Hidden.ValueBool(),
+		Route:        data.Route.ValueString(),
+		Title:        data.Title.ValueString(),
+	}, r.client.Options(ctx)...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create page, got error: %s", err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "created a page")
+
+	// Save computed attributes in state
+	data.ID = types.StringValue(strconv.Itoa(res.ID))
+
+	// Create files
+	if len(data.Files) > 0 {
+		uploadedFiles, fileDiags := utils.CreateFiles(ctx, r.client, pageOwner(res.ID), data.Files)
+		resp.Diagnostics.Append(fileDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Files = uploadedFiles
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *pageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_page", "Read")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	var data pageResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.GetPage(data.ID.ValueString(), r.client.Options(ctx)...)
+	if err != nil {
+		if utils.IsNotFound(err) {
+			// Deleted outside of Terraform, plan its re-creation
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read page %s, got error: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	data.Route = types.StringValue(res.Route)
+	data.Title = types.StringValue(res.Title)
+	data.Content = utils.ToTFString(res.Content)
+	data.Format = types.StringValue(res.Format)
+	data.Draft = types.BoolValue(res.Draft)
+	data.Hidden = types.BoolValue(res.Hidden)
+	data.AuthRequired = types.BoolValue(res.AuthRequired)
+
+	files, fileDiags := utils.ReadFiles(ctx, r.client, pageOwner(res.ID), data.Files)
+	resp.Diagnostics.Append(fileDiags...)
+	data.Files = files
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *pageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_page", "Update")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	var data pageResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var dataState pageResourceModel
+	req.State.Get(ctx, &dataState)
+
+	if _, err := r.client.PatchPage(data.ID.ValueString(), &api.PatchPageParams{
+		Title:        data.Title.ValueString(),
+		Content:      data.Content.ValueString(),
+		Format:       data.Format.ValueString(),
+		Route:        data.Route.ValueString(),
+		AuthRequired: data.AuthRequired.ValueBool(),
+		Draft:        data.Draft.ValueBool(),
+		Hidden:       data.Hidden.ValueBool(),
+	}, r.client.Options(ctx)...); err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to update page %s, got error: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	// Update files
+	syncedFiles, fileDiags := utils.SyncFilesOnUpdate(ctx, r.client, pageOwner(utils.Atoi(data.ID.ValueString())), dataState.Files, data.Files)
+	resp.Diagnostics.Append(fileDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Files = syncedFiles
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *pageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_page", "Delete")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	var data pageResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeletePage(data.ID.ValueString(), r.client.Options(ctx)...); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete page %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	// ... don't need to delete files, this is handled by CTFd
+}
+
+func (r *pageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	// Automatically call r.Read
+}
+
+//
+// Starting from this are helper or types-specific code related to the ctfd_page resource
+//
+
+func pageOwner(pageID int) utils.FileOwner {
+	return utils.FileOwner{
+		Type: "page",
+		Page: &pageID,
+	}
+}
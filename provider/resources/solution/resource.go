@@ -16,7 +16,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 
 	"github.com/AlexEreh/terraform-provider-ctfd/provider/utils"
 	"github.com/AlexEreh/terraform-provider-ctfd/provider/validators"
@@ -33,7 +32,7 @@ func NewSolutionResource() resource.Resource {
 }
 
 type solutionResource struct {
-	client *api.Client
+	client *utils.Client
 }
 
 type solutionResourceModel struct {
@@ -89,11 +88,11 @@ func (r *solutionResource) Configure(ctx context.Context, req resource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*api.Client)
+	client, ok := req.ProviderData.(*utils.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *github.com/ctfer-io/go-ctfd/api.Client, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", req.ProviderData),
+			fmt.Sprintf("Expected *github.com/AlexEreh/terraform-provider-ctfd/provider/utils.Client, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", req.ProviderData),
 		)
 		return
 	}
@@ -102,6 +101,9 @@ func (r *solutionResource) Configure(ctx context.Context, req resource.Configure
 }
 
 func (r *solutionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_solution", "Create")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
 	var data solutionResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -113,7 +115,7 @@ func (r *solutionResource) Create(ctx context.Context, req resource.CreateReques
 		ChallengeID: utils.Atoi(data.ChallengeID.ValueString()),
 		Content:     data.Content.ValueString(),
 		State:       data.State.ValueString(),
-	}, api.WithContext(ctx), api.WithTransport(otelhttp.NewTransport(nil)))
+	}, r.client.Options(ctx)...)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error",
@@ -134,6 +136,9 @@ func (r *solutionResource) Create(ctx context.Context, req resource.CreateReques
 }
 
 func (r *solutionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_solution", "Read")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
 	var data solutionResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -141,10 +146,13 @@ func (r *solutionResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	// Retrieve solution
-	res, err := r.client.GetSolutions(utils.Atoi(data.ID.ValueString()), nil,
-		api.WithContext(ctx), api.WithTransport(otelhttp.NewTransport(nil)),
-	)
+	res, err := r.client.GetSolutions(utils.Atoi(data.ID.ValueString()), nil, r.client.Options(ctx)...)
 	if err != nil {
+		if utils.IsNotFound(err) {
+			// Deleted outside of Terraform, plan its re-creation
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client Error",
 			fmt.Sprintf("Unable to read solution of challenge %s, got error: %s", data.ID.ValueString(), err),
@@ -164,6 +172,9 @@ func (r *solutionResource) Read(ctx context.Context, req resource.ReadRequest, r
 }
 
 func (r *solutionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_solution", "Update")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
 	var data solutionResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -174,7 +185,7 @@ func (r *solutionResource) Update(ctx context.Context, req resource.UpdateReques
 	if _, err := r.client.PatchSolutions(utils.Atoi(data.ID.ValueString()), &api.PatchSolutionsParams{
 		Content: data.Content.ValueString(),
 		State:   data.State.ValueString(),
-	}, api.WithContext(ctx), api.WithTransport(otelhttp.NewTransport(nil))); err != nil {
+	}, r.client.Options(ctx)...); err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error",
 			fmt.Sprintf("Unable to update solution of challenge %s, got error: %s", data.ChallengeID.ValueString(), err),
@@ -189,15 +200,16 @@ func (r *solutionResource) Update(ctx context.Context, req resource.UpdateReques
 }
 
 func (r *solutionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_solution", "Delete")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
 	var data solutionResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if err := r.client.DeleteSolutions(utils.Atoi(data.ID.ValueString()),
-		api.WithContext(ctx), api.WithTransport(otelhttp.NewTransport(nil)),
-	); err != nil {
+	if err := r.client.DeleteSolutions(utils.Atoi(data.ID.ValueString()), r.client.Options(ctx)...); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete solution of challenge %s, got error: %s", data.ChallengeID.ValueString(), err))
 		return
 	}
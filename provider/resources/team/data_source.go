@@ -10,7 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/utils"
 )
 
 var (
@@ -23,12 +24,19 @@ func NewTeamDataSource() datasource.DataSource {
 }
 
 type teamDataSource struct {
-	client *api.Client
+	client *utils.Client
 }
 
 type teamsDataSourceModel struct {
-	ID    types.String        `tfsdk:"id"`
-	Teams []teamResourceModel `tfsdk:"teams"`
+	ID          types.String        `tfsdk:"id"`
+	Name        types.String        `tfsdk:"name"`
+	Email       types.String        `tfsdk:"email"`
+	Affiliation types.String        `tfsdk:"affiliation"`
+	Country     types.String        `tfsdk:"country"`
+	BracketID   types.String        `tfsdk:"bracket_id"`
+	Hidden      types.Bool          `tfsdk:"hidden"`
+	Banned      types.Bool          `tfsdk:"banned"`
+	Teams       []teamResourceModel `tfsdk:"teams"`
 }
 
 func (team *teamDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -37,60 +45,43 @@ func (team *teamDataSource) Metadata(ctx context.Context, req datasource.Metadat
 
 func (team *teamDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the teams of the CTFd instance, eventually filtered. The `name`, `email`, `affiliation`, `country` and `bracket_id` filters are handled by CTFd, the others are applied afterward.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Only list the team with this name.",
+				Optional:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Only list the team with this email.",
+				Optional:            true,
+			},
+			"affiliation": schema.StringAttribute{
+				MarkdownDescription: "Only list the teams with this affiliation.",
+				Optional:            true,
+			},
+			"country": schema.StringAttribute{
+				MarkdownDescription: "Only list the teams from this country.",
+				Optional:            true,
+			},
+			"bracket_id": schema.StringAttribute{
+				MarkdownDescription: "Only list the teams playing in this bracket.",
+				Optional:            true,
+			},
+			"hidden": schema.BoolAttribute{
+				MarkdownDescription: "Only list the teams that are hidden (if true) or not (if false).",
+				Optional:            true,
+			},
+			"banned": schema.BoolAttribute{
+				MarkdownDescription: "Only list the teams that are banned (if true) or not (if false).",
+				Optional:            true,
+			},
 			"teams": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"id": schema.StringAttribute{
-							MarkdownDescription: "Identifier of the user.",
-							Computed:            true,
-						},
-						"name": schema.StringAttribute{
-							MarkdownDescription: "Name of the team.",
-							Computed:            true,
-						},
-						"email": schema.StringAttribute{
-							MarkdownDescription: "Email of the team.",
-							Computed:            true,
-						},
-						"password": schema.StringAttribute{
-							MarkdownDescription: "Password of the team. Notice that during a CTF you may not want to update those to avoid defaulting team accesses.",
-							Computed:            true,
-						},
-						"website": schema.StringAttribute{
-							MarkdownDescription: "Website, blog, or anything similar (displayed to other participants).",
-							Computed:            true,
-						},
-						"affiliation": schema.StringAttribute{
-							MarkdownDescription: "Affiliation to a company or agency.",
-							Computed:            true,
-						},
-						"country": schema.StringAttribute{
-							MarkdownDescription: "Country the team represent or is hail from.",
-							Computed:            true,
-						},
-						"hidden": schema.BoolAttribute{
-							MarkdownDescription: "Is true if the team is hidden to the participants.",
-							Computed:            true,
-						},
-						"banned": schema.BoolAttribute{
-							MarkdownDescription: "Is true if the team is banned from the CTF.",
-							Computed:            true,
-						},
-						"members": schema.ListAttribute{
-							MarkdownDescription: "List of members (User), defined by their IDs.",
-							ElementType:         types.StringType,
-							Computed:            true,
-						},
-						"captain": schema.StringAttribute{
-							MarkdownDescription: "Member who is captain of the team. Must be part of the members too. Note it could cause a fatal error in case of resource import with an inconsistent CTFd configuration i.e. if a team has no captain yet (should not be possible).",
-							Computed:            true,
-						},
-					},
+					Attributes: teamDataSourceAttributes(false),
 				},
 			},
 		},
@@ -102,11 +93,11 @@ func (team *teamDataSource) Configure(ctx context.Context, req datasource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*api.Client)
+	client, ok := req.ProviderData.(*utils.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *github.com/ctfer-io/go-ctfd/api.Client, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", req.ProviderData),
+			fmt.Sprintf("Expected *github.com/AlexEreh/terraform-provider-ctfd/provider/utils.Client, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", req.ProviderData),
 		)
 		return
 	}
@@ -115,9 +106,24 @@ func (team *teamDataSource) Configure(ctx context.Context, req datasource.Config
 }
 
 func (team *teamDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_teams", "Read")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
 	var state teamsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	teams, err := team.client.GetTeams(&api.GetTeamsParams{}, api.WithContext(ctx), api.WithTransport(otelhttp.NewTransport(nil)))
+	teams, err := getTeams(ctx, team.client, teamFilters{
+		Name:        state.Name,
+		Email:       state.Email,
+		Affiliation: state.Affiliation,
+		Country:     state.Country,
+		BracketID:   state.BracketID,
+		Hidden:      state.Hidden,
+		Banned:      state.Banned,
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read CTFd Teams",
@@ -128,27 +134,169 @@ func (team *teamDataSource) Read(ctx context.Context, req datasource.ReadRequest
 
 	state.Teams = make([]teamResourceModel, 0, len(teams))
 	for _, t := range teams {
-		// Flatten response
-		members := make([]basetypes.StringValue, 0, len(t.Members))
-		for _, tm := range t.Members {
-			members = append(members, types.StringValue(strconv.Itoa(tm)))
-		}
-		state.Teams = append(state.Teams, teamResourceModel{
-			ID:          types.StringValue(strconv.Itoa(t.ID)),
-			Name:        types.StringValue(t.Name),
-			Email:       types.StringValue(*t.Email),
-			Password:    types.StringValue("placeholder"),
-			Website:     types.StringValue(*t.Website),
-			Affiliation: types.StringValue(*t.Affiliation),
-			Country:     types.StringValue(*t.Country),
-			Hidden:      types.BoolValue(t.Hidden),
-			Banned:      types.BoolValue(t.Banned),
-			Members:     members,
-			Captain:     types.StringValue(strconv.Itoa(*t.CaptainID)),
-		})
+		state.Teams = append(state.Teams, flattenTeam(t))
 	}
 
 	state.ID = types.StringValue("placeholder")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
+
+// getTeamsParams is a replacement of api.GetTeamsParams, as the latter
+// does not omit empty values (thus filters on "null") and can't
+// request the admin view, which is necessary to list hidden and banned
+// teams.
+type getTeamsParams struct {
+	Affiliation *string `schema:"affiliation,omitempty"`
+	Country     *string `schema:"country,omitempty"`
+	Bracket     *string `schema:"bracket,omitempty"`
+	Q           *string `schema:"q,omitempty"`
+	Field       *string `schema:"field,omitempty"`
+	View        *string `schema:"view,omitempty"`
+
+	utils.Pagination
+}
+
+// teamFilters contains the filters to apply when listing teams.
+// Null values are not filtered on.
+type teamFilters struct {
+	Name        types.String
+	Email       types.String
+	Affiliation types.String
+	Country     types.String
+	BracketID   types.String
+	Hidden      types.Bool
+	Banned      types.Bool
+}
+
+// getTeams lists the teams matching the filters, through all pages.
+// As much as possible is filtered by CTFd, the rest afterward.
+func getTeams(ctx context.Context, client *utils.Client, filters teamFilters) ([]*api.Team, error) {
+	params := &getTeamsParams{
+		Affiliation: filters.Affiliation.ValueStringPointer(),
+		Country:     filters.Country.ValueStringPointer(),
+		Bracket:     filters.BracketID.ValueStringPointer(),
+		View:        utils.Ptr("admin"),
+	}
+	// CTFd only supports searching on a single field, and it is a
+	// partial match, so it still needs to be filtered afterward.
+	switch {
+	case !filters.Name.IsNull():
+		params.Q = filters.Name.ValueStringPointer()
+		params.Field = utils.Ptr("name")
+	case !filters.Email.IsNull():
+		params.Q = filters.Email.ValueStringPointer()
+		params.Field = utils.Ptr("email")
+	}
+
+	teams, err := utils.GetPages[*api.Team](ctx, client, "/teams", params)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*api.Team, 0, len(teams))
+	for _, t := range teams {
+		if !filters.Name.IsNull() && t.Name != filters.Name.ValueString() {
+			continue
+		}
+		if !filters.Email.IsNull() && (t.Email == nil || *t.Email != filters.Email.ValueString()) {
+			continue
+		}
+		if !filters.Hidden.IsNull() && t.Hidden != filters.Hidden.ValueBool() {
+			continue
+		}
+		if !filters.Banned.IsNull() && t.Banned != filters.Banned.ValueBool() {
+			continue
+		}
+		res = append(res, t)
+	}
+	return res, nil
+}
+
+func flattenTeam(t *api.Team) teamResourceModel {
+	members := make([]basetypes.StringValue, 0, len(t.Members))
+	for _, tm := range t.Members {
+		members = append(members, types.StringValue(strconv.Itoa(tm)))
+	}
+	team := teamResourceModel{
+		ID:          types.StringValue(strconv.Itoa(t.ID)),
+		Name:        types.StringValue(t.Name),
+		Email:       types.StringPointerValue(t.Email),
+		Password:    types.StringValue("placeholder"),
+		Website:     types.StringPointerValue(t.Website),
+		Affiliation: types.StringPointerValue(t.Affiliation),
+		Country:     types.StringPointerValue(t.Country),
+		Hidden:      types.BoolValue(t.Hidden),
+		Banned:      types.BoolValue(t.Banned),
+		Members:     members,
+		Captain:     types.StringNull(),
+		BracketID:   types.StringNull(),
+	}
+	if t.CaptainID != nil {
+		team.Captain = types.StringValue(strconv.Itoa(*t.CaptainID))
+	}
+	if t.BracketID != nil {
+		team.BracketID = types.StringValue(strconv.Itoa(*t.BracketID))
+	}
+	return team
+}
+
+// teamDataSourceAttributes returns the attributes of a team as read
+// by a data source. If lookup is true, the attributes that are used
+// to look for a single team are also configurable.
+func teamDataSourceAttributes(lookup bool) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Identifier of the team.",
+			Optional:            lookup,
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the team.",
+			Optional:            lookup,
+			Computed:            true,
+		},
+		"email": schema.StringAttribute{
+			MarkdownDescription: "Email of the team.",
+			Optional:            lookup,
+			Computed:            true,
+		},
+		"password": schema.StringAttribute{
+			MarkdownDescription: "Password of the team. Notice that during a CTF you may not want to update those to avoid defaulting team accesses.",
+			Computed:            true,
+		},
+		"website": schema.StringAttribute{
+			MarkdownDescription: "Website, blog, or anything similar (displayed to other participants).",
+			Computed:            true,
+		},
+		"affiliation": schema.StringAttribute{
+			MarkdownDescription: "Affiliation to a company or agency.",
+			Computed:            true,
+		},
+		"country": schema.StringAttribute{
+			MarkdownDescription: "Country the team represent or is hail from.",
+			Computed:            true,
+		},
+		"hidden": schema.BoolAttribute{
+			MarkdownDescription: "Is true if the team is hidden to the participants.",
+			Computed:            true,
+		},
+		"banned": schema.BoolAttribute{
+			MarkdownDescription: "Is true if the team is banned from the CTF.",
+			Computed:            true,
+		},
+		"members": schema.ListAttribute{
+			MarkdownDescription: "List of members (User), defined by their IDs.",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+		"captain": schema.StringAttribute{
+			MarkdownDescription: "Member who is captain of the team. Must be part of the members too. Note it could cause a fatal error in case of resource import with an inconsistent CTFd configuration i.e. if a team has no captain yet (should not be possible).",
+			Computed:            true,
+		},
+		"bracket_id": schema.StringAttribute{
+			MarkdownDescription: "The bracket id the team plays in.",
+			Computed:            true,
+		},
+	}
+}
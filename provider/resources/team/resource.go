@@ -16,7 +16,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 var (
@@ -45,7 +44,7 @@ func NewTeamResource() resource.Resource {
 }
 
 type teamResource struct {
-	client *api.Client
+	client *utils.Client
 }
 
 func (r *teamResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -112,7 +111,7 @@ func (r *teamResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Required:            true,
 			},
 			"bracket_id": schema.StringAttribute{
-				MarkdownDescription: "The bracket id the user plays in.",
+				MarkdownDescription: "The bracket id the team plays in (e.g. `ctfd_bracket.x.id`).",
 				Optional:            true,
 			},
 		},
@@ -125,11 +124,11 @@ func (r *teamResource) Configure(ctx context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	client, ok := req.ProviderData.(*api.Client)
+	client, ok := req.ProviderData.(*utils.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *github.com/ctfer-io/go-ctfd/api.Client, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", req.ProviderData),
+			fmt.Sprintf("Expected *github.com/AlexEreh/terraform-provider-ctfd/provider/utils.Client, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", req.ProviderData),
 		)
 		return
 	}
@@ -138,6 +137,9 @@ func (r *teamResource) Configure(ctx context.Context, req resource.ConfigureRequ
 }
 
 func (r *teamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_team", "Create")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
 	var data teamResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -170,7 +172,7 @@ func (r *teamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	for _, mem := range data.Members {
 		_, err := r.client.PostTeamMembers(res.ID, &api.PostTeamsMembersParams{
 			UserID: utils.Atoi(mem.ValueString()),
-		}, api.WithContext(ctx), api.WithTransport(otelhttp.NewTransport(nil)))
+		}, r.client.Options(ctx)...)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Client Error",
@@ -199,6 +201,9 @@ func (r *teamResource) Create(ctx context.Context, req resource.CreateRequest, r
 }
 
 func (r *teamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_team", "Read")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
 	var data teamResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -206,8 +211,13 @@ func (r *teamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	teamId := utils.Atoi(data.ID.ValueString())
-	res, err := r.client.GetTeam(teamId, api.WithContext(ctx), api.WithTransport(otelhttp.NewTransport(nil)))
+	res, err := r.client.GetTeam(teamId, r.client.Options(ctx)...)
 	if err != nil {
+		if utils.IsNotFound(err) {
+			// Deleted outside of Terraform, plan its re-creation
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client Error",
 			fmt.Sprintf("Unable to read team %s, got error: %s", data.ID.ValueString(), err),
@@ -228,7 +238,7 @@ func (r *teamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	// password is not returned, which is good :)
 
 	// => Members
-	mems, err := r.client.GetTeamMembers(teamId, api.WithContext(ctx), api.WithTransport(otelhttp.NewTransport(nil)))
+	mems, err := r.client.GetTeamMembers(teamId, r.client.Options(ctx)...)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error",
@@ -250,6 +260,9 @@ func (r *teamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *teamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_team", "Update")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
 	var data teamResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -268,7 +281,7 @@ func (r *teamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		Banned:      data.Banned.ValueBoolPointer(),
 		Fields:      []api.Field{},
 		BracketID:   data.BracketID.ValueStringPointer(),
-	}, api.WithContext(ctx), api.WithTransport(otelhttp.NewTransport(nil)))
+	}, r.client.Options(ctx)...)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error",
@@ -278,7 +291,7 @@ func (r *teamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	// => Members
-	currentMembers, err := r.client.GetTeamMembers(teamId, api.WithContext(ctx), api.WithTransport(otelhttp.NewTransport(nil)))
+	currentMembers, err := r.client.GetTeamMembers(teamId, r.client.Options(ctx)...)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error",
@@ -298,7 +311,7 @@ func (r *teamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		if !exists {
 			if _, err := r.client.PostTeamMembers(teamId, &api.PostTeamsMembersParams{
 				UserID: utils.Atoi(tfMember.ValueString()),
-			}, api.WithContext(ctx), api.WithTransport(otelhttp.NewTransport(nil))); err != nil {
+			}, r.client.Options(ctx)...); err != nil {
 				resp.Diagnostics.AddError(
 					"Client Error",
 					fmt.Sprintf("Unable to post team's %d member %s, got error: %s", teamId, tfMember.ValueString(), err),
@@ -320,7 +333,7 @@ func (r *teamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		if !exists {
 			if _, err := r.client.DeleteTeamMembers(teamId, &api.DeleteTeamMembersParams{
 				UserID: currentMember,
-			}, api.WithContext(ctx), api.WithTransport(otelhttp.NewTransport(nil))); err != nil {
+			}, r.client.Options(ctx)...); err != nil {
 				resp.Diagnostics.AddError(
 					"Client Error",
 					fmt.Sprintf("Unable to delete team's %d member %d, got error: %s", teamId, currentMember, err),
@@ -352,13 +365,16 @@ func (r *teamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 }
 
 func (r *teamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_team", "Delete")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
 	var data teamResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if err := r.client.DeleteTeam(utils.Atoi(data.ID.ValueString()), api.WithContext(ctx), api.WithTransport(otelhttp.NewTransport(nil))); err != nil {
+	if err := r.client.DeleteTeam(utils.Atoi(data.ID.ValueString()), r.client.Options(ctx)...); err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error",
 			fmt.Sprintf("Unable to delete team %s, got error: %s", data.ID.ValueString(), err),
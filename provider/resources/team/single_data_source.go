@@ -0,0 +1,109 @@
+package team
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ctfer-io/go-ctfd/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/utils"
+)
+
+var (
+	_ datasource.DataSource              = (*singleTeamDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*singleTeamDataSource)(nil)
+)
+
+func NewSingleTeamDataSource() datasource.DataSource {
+	return &singleTeamDataSource{}
+}
+
+type singleTeamDataSource struct {
+	client *utils.Client
+}
+
+func (team *singleTeamDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+func (team *singleTeamDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Look up a single team, either by its `id`, `name` or `email`.",
+		Attributes:          teamDataSourceAttributes(true),
+	}
+}
+
+func (team *singleTeamDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*utils.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *github.com/AlexEreh/terraform-provider-ctfd/provider/utils.Client, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", req.ProviderData),
+		)
+		return
+	}
+
+	team.client = client
+}
+
+func (team *singleTeamDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_team", "Read")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	var config teamResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var t *api.Team
+	switch {
+	case !config.ID.IsNull():
+		res, err := team.client.GetTeam(utils.Atoi(config.ID.ValueString()), team.client.Options(ctx)...)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to read team %s, got error: %s", config.ID.ValueString(), err),
+			)
+			return
+		}
+		t = res
+
+	case !config.Name.IsNull() || !config.Email.IsNull():
+		teams, err := getTeams(ctx, team.client, teamFilters{
+			Name:  config.Name,
+			Email: config.Email,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read CTFd Teams",
+				err.Error(),
+			)
+			return
+		}
+		if len(teams) != 1 {
+			resp.Diagnostics.AddError(
+				"Invalid Team Lookup",
+				fmt.Sprintf("Expected a single team to match, got %d.", len(teams)),
+			)
+			return
+		}
+		t = teams[0]
+
+	default:
+		resp.Diagnostics.AddError(
+			"Invalid Team Lookup",
+			"Either id, name or email must be set to look up a team.",
+		)
+		return
+	}
+
+	state := flattenTeam(t)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -9,7 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/utils"
 )
 
 var (
@@ -22,12 +23,20 @@ func NewUserDataSource() datasource.DataSource {
 }
 
 type userDataSource struct {
-	client *api.Client
+	client *utils.Client
 }
 
 type usersDataSourceModel struct {
-	ID    types.String        `tfsdk:"id"`
-	Users []userResourceModel `tfsdk:"users"`
+	ID          types.String        `tfsdk:"id"`
+	Name        types.String        `tfsdk:"name"`
+	Email       types.String        `tfsdk:"email"`
+	Affiliation types.String        `tfsdk:"affiliation"`
+	Country     types.String        `tfsdk:"country"`
+	Type        types.String        `tfsdk:"type"`
+	BracketID   types.String        `tfsdk:"bracket_id"`
+	Hidden      types.Bool          `tfsdk:"hidden"`
+	Banned      types.Bool          `tfsdk:"banned"`
+	Users       []userResourceModel `tfsdk:"users"`
 }
 
 func (usr *userDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -36,63 +45,48 @@ func (usr *userDataSource) Metadata(ctx context.Context, req datasource.Metadata
 
 func (usr *userDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the users of the CTFd instance, eventually filtered. The `name`, `email`, `affiliation`, `country` and `bracket_id` filters are handled by CTFd, the others are applied afterward.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Only list the user with this name.",
+				Optional:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Only list the user with this email.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"affiliation": schema.StringAttribute{
+				MarkdownDescription: "Only list the users with this affiliation.",
+				Optional:            true,
+			},
+			"country": schema.StringAttribute{
+				MarkdownDescription: "Only list the users from this country.",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only list the users of this type, either user or admin.",
+				Optional:            true,
+			},
+			"bracket_id": schema.StringAttribute{
+				MarkdownDescription: "Only list the users playing in this bracket.",
+				Optional:            true,
+			},
+			"hidden": schema.BoolAttribute{
+				MarkdownDescription: "Only list the users that are hidden (if true) or not (if false).",
+				Optional:            true,
+			},
+			"banned": schema.BoolAttribute{
+				MarkdownDescription: "Only list the users that are banned (if true) or not (if false).",
+				Optional:            true,
+			},
 			"users": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"id": schema.StringAttribute{
-							MarkdownDescription: "Identifier of the user.",
-							Computed:            true,
-						},
-						"name": schema.StringAttribute{
-							MarkdownDescription: "Name or pseudo of the user.",
-							Computed:            true,
-						},
-						"email": schema.StringAttribute{
-							MarkdownDescription: "Email of the user, may be used to verify the account.",
-							Computed:            true,
-						},
-						"password": schema.StringAttribute{
-							MarkdownDescription: "Password of the user. Notice that during a CTF you may not want to update those to avoid defaulting user accesses.",
-							Computed:            true,
-						},
-						"website": schema.StringAttribute{
-							MarkdownDescription: "Website, blog, or anything similar (displayed to other participants).",
-							Computed:            true,
-						},
-						"affiliation": schema.StringAttribute{
-							MarkdownDescription: "Affiliation to a team, company or agency.",
-							Computed:            true,
-						},
-						"country": schema.StringAttribute{
-							MarkdownDescription: "Country the user represent or is native from.",
-							Computed:            true,
-						},
-						"language": schema.StringAttribute{
-							MarkdownDescription: "Language the user is fluent in.",
-							Computed:            true,
-						},
-						"type": schema.StringAttribute{
-							MarkdownDescription: "Generic type for RBAC purposes.",
-							Computed:            true,
-						},
-						"verified": schema.BoolAttribute{
-							MarkdownDescription: "Is true if the user has verified its account by email, or if set by an admin.",
-							Computed:            true,
-						},
-						"hidden": schema.BoolAttribute{
-							MarkdownDescription: "Is true if the user is hidden to the participants.",
-							Computed:            true,
-						},
-						"banned": schema.BoolAttribute{
-							MarkdownDescription: "Is true if the user is banned from the CTF.",
-							Computed:            true,
-						},
-					},
+					Attributes: userDataSourceAttributes(false),
 				},
 			},
 		},
@@ -104,11 +98,11 @@ func (usr *userDataSource) Configure(ctx context.Context, req datasource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*api.Client)
+	client, ok := req.ProviderData.(*utils.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *github.com/ctfer-io/go-ctfd/api.Client, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", req.ProviderData),
+			fmt.Sprintf("Expected *github.com/AlexEreh/terraform-provider-ctfd/provider/utils.Client, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", req.ProviderData),
 		)
 		return
 	}
@@ -117,9 +111,25 @@ func (usr *userDataSource) Configure(ctx context.Context, req datasource.Configu
 }
 
 func (usr *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_users", "Read")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
 	var state usersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	users, err := usr.client.GetUsers(&api.GetUsersParams{}, api.WithContext(ctx), api.WithTransport(otelhttp.NewTransport(nil)))
+	users, err := getUsers(ctx, usr.client, userFilters{
+		Name:        state.Name,
+		Email:       state.Email,
+		Affiliation: state.Affiliation,
+		Country:     state.Country,
+		Type:        state.Type,
+		BracketID:   state.BracketID,
+		Hidden:      state.Hidden,
+		Banned:      state.Banned,
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read CTFd Users",
@@ -130,24 +140,170 @@ func (usr *userDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	state.Users = make([]userResourceModel, 0, len(users))
 	for _, u := range users {
-		// Flatten response
-		state.Users = append(state.Users, userResourceModel{
-			ID:          types.StringValue(strconv.Itoa(u.ID)),
-			Name:        types.StringValue(u.Name),
-			Email:       types.StringPointerValue(u.Email),
-			Password:    types.StringValue("placeholder"),
-			Website:     types.StringPointerValue(u.Website),
-			Affiliation: types.StringPointerValue(u.Affiliation),
-			Country:     types.StringPointerValue(u.Country),
-			Language:    types.StringPointerValue(u.Language),
-			Type:        types.StringPointerValue(u.Type),
-			Verified:    types.BoolPointerValue(u.Verified),
-			Hidden:      types.BoolPointerValue(u.Hidden),
-			Banned:      types.BoolPointerValue(u.Banned),
-		})
+		state.Users = append(state.Users, flattenUser(u))
 	}
 
 	state.ID = types.StringValue("placeholder")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
+
+// getUsersParams is a replacement of api.GetUsersParams, as the latter
+// does not omit empty values (thus filters on "null") and can't
+// request the admin view, which is necessary to list hidden and banned
+// users.
+type getUsersParams struct {
+	Affiliation *string `schema:"affiliation,omitempty"`
+	Country     *string `schema:"country,omitempty"`
+	Bracket     *string `schema:"bracket,omitempty"`
+	Q           *string `schema:"q,omitempty"`
+	Field       *string `schema:"field,omitempty"`
+	View        *string `schema:"view,omitempty"`
+
+	utils.Pagination
+}
+
+// userFilters contains the filters to apply when listing users.
+// Null values are not filtered on.
+type userFilters struct {
+	Name        types.String
+	Email       types.String
+	Affiliation types.String
+	Country     types.String
+	Type        types.String
+	BracketID   types.String
+	Hidden      types.Bool
+	Banned      types.Bool
+}
+
+// getUsers lists the users matching the filters, through all pages.
+// As much as possible is filtered by CTFd, the rest afterward.
+func getUsers(ctx context.Context, client *utils.Client, filters userFilters) ([]*api.User, error) {
+	params := &getUsersParams{
+		Affiliation: filters.Affiliation.ValueStringPointer(),
+		Country:     filters.Country.ValueStringPointer(),
+		Bracket:     filters.BracketID.ValueStringPointer(),
+		View:        utils.Ptr("admin"),
+	}
+	// CTFd only supports searching on a single field, and it is a
+	// partial match, so it still needs to be filtered afterward.
+	switch {
+	case !filters.Name.IsNull():
+		params.Q = filters.Name.ValueStringPointer()
+		params.Field = utils.Ptr("name")
+	case !filters.Email.IsNull():
+		params.Q = filters.Email.ValueStringPointer()
+		params.Field = utils.Ptr("email")
+	}
+
+	users, err := utils.GetPages[*api.User](ctx, client, "/users", params)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*api.User, 0, len(users))
+	for _, u := range users {
+		if !filters.Name.IsNull() && u.Name != filters.Name.ValueString() {
+			continue
+		}
+		if !filters.Email.IsNull() && (u.Email == nil || *u.Email != filters.Email.ValueString()) {
+			continue
+		}
+		if !filters.Type.IsNull() && (u.Type == nil || *u.Type != filters.Type.ValueString()) {
+			continue
+		}
+		if !filters.Hidden.IsNull() && (u.Hidden != nil && *u.Hidden) != filters.Hidden.ValueBool() {
+			continue
+		}
+		if !filters.Banned.IsNull() && (u.Banned != nil && *u.Banned) != filters.Banned.ValueBool() {
+			continue
+		}
+		res = append(res, u)
+	}
+	return res, nil
+}
+
+func flattenUser(u *api.User) userResourceModel {
+	usr := userResourceModel{
+		ID:          types.StringValue(strconv.Itoa(u.ID)),
+		Name:        types.StringValue(u.Name),
+		Email:       types.StringPointerValue(u.Email),
+		Password:    types.StringValue("placeholder"),
+		Website:     types.StringPointerValue(u.Website),
+		Affiliation: types.StringPointerValue(u.Affiliation),
+		Country:     types.StringPointerValue(u.Country),
+		Language:    types.StringPointerValue(u.Language),
+		Type:        types.StringPointerValue(u.Type),
+		Verified:    types.BoolPointerValue(u.Verified),
+		Hidden:      types.BoolPointerValue(u.Hidden),
+		Banned:      types.BoolPointerValue(u.Banned),
+		BracketID:   types.StringNull(),
+	}
+	if u.BracketID != nil {
+		usr.BracketID = types.StringValue(strconv.Itoa(*u.BracketID))
+	}
+	return usr
+}
+
+// userDataSourceAttributes returns the attributes of a user as read
+// by a data source. If lookup is true, the attributes that are used
+// to look for a single user are also configurable.
+func userDataSourceAttributes(lookup bool) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Identifier of the user.",
+			Optional:            lookup,
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name or pseudo of the user.",
+			Optional:            lookup,
+			Computed:            true,
+		},
+		"email": schema.StringAttribute{
+			MarkdownDescription: "Email of the user, may be used to verify the account.",
+			Optional:            lookup,
+			Computed:            true,
+		},
+		"password": schema.StringAttribute{
+			MarkdownDescription: "Password of the user. Notice that during a CTF you may not want to update those to avoid defaulting user accesses.",
+			Computed:            true,
+		},
+		"website": schema.StringAttribute{
+			MarkdownDescription: "Website, blog, or anything similar (displayed to other participants).",
+			Computed:            true,
+		},
+		"affiliation": schema.StringAttribute{
+			MarkdownDescription: "Affiliation to a team, company or agency.",
+			Computed:            true,
+		},
+		"country": schema.StringAttribute{
+			MarkdownDescription: "Country the user represent or is native from.",
+			Computed:            true,
+		},
+		"language": schema.StringAttribute{
+			MarkdownDescription: "Language the user is fluent in.",
+			Computed:            true,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "Generic type for RBAC purposes.",
+			Computed:            true,
+		},
+		"verified": schema.BoolAttribute{
+			MarkdownDescription: "Is true if the user has verified its account by email, or if set by an admin.",
+			Computed:            true,
+		},
+		"hidden": schema.BoolAttribute{
+			MarkdownDescription: "Is true if the user is hidden to the participants.",
+			Computed:            true,
+		},
+		"banned": schema.BoolAttribute{
+			MarkdownDescription: "Is true if the user is banned from the CTF.",
+			Computed:            true,
+		},
+		"bracket_id": schema.StringAttribute{
+			MarkdownDescription: "The bracket id the user plays in.",
+			Computed:            true,
+		},
+	}
+}
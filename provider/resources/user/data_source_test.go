@@ -0,0 +1,62 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ctfer-io/go-ctfd/api"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/utils"
+)
+
+func Test_U_GetUsers(t *testing.T) {
+	// CTFd partially matches "alice", the exact match is on the 2nd page
+	pages := map[string][]map[string]any{
+		"1": {
+			{"id": 1, "name": "alice2", "hidden": false},
+			{"id": 2, "name": "malice", "hidden": true},
+		},
+		"2": {
+			{"id": 3, "name": "alice", "hidden": true},
+		},
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("q") != "alice" || q.Get("field") != "name" || q.Get("view") != "admin" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var next *int
+		if q.Get("page") == "1" {
+			next = utils.Ptr(2)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"success": true,
+			"meta": map[string]any{
+				"pagination": map[string]any{
+					"next": next,
+				},
+			},
+			"data": pages[q.Get("page")],
+		})
+	}))
+	defer srv.Close()
+
+	client := utils.NewClient(api.NewClient(srv.URL, "", "", "key"), http.DefaultTransport)
+
+	users, err := getUsers(context.Background(), client, userFilters{
+		Name:   types.StringValue("alice"),
+		Hidden: types.BoolValue(true),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(users) != 1 || users[0].ID != 3 {
+		t.Fatalf("expected user 3 only, got %v", users)
+	}
+}
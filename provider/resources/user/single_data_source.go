@@ -0,0 +1,109 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ctfer-io/go-ctfd/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/AlexEreh/terraform-provider-ctfd/provider/utils"
+)
+
+var (
+	_ datasource.DataSource              = (*singleUserDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*singleUserDataSource)(nil)
+)
+
+func NewSingleUserDataSource() datasource.DataSource {
+	return &singleUserDataSource{}
+}
+
+type singleUserDataSource struct {
+	client *utils.Client
+}
+
+func (usr *singleUserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (usr *singleUserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Look up a single user, either by its `id`, `name` or `email`.",
+		Attributes:          userDataSourceAttributes(true),
+	}
+}
+
+func (usr *singleUserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*utils.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *github.com/AlexEreh/terraform-provider-ctfd/provider/utils.Client, got: %T. Please open an issue at https://github.com/ctfer-io/terraform-provider-ctfd", req.ProviderData),
+		)
+		return
+	}
+
+	usr.client = client
+}
+
+func (usr *singleUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, span := utils.StartSpan(ctx, "ctfd_user", "Read")
+	defer utils.EndSpan(span, &resp.Diagnostics)
+
+	var config userResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var user *api.User
+	switch {
+	case !config.ID.IsNull():
+		res, err := usr.client.GetUser(utils.Atoi(config.ID.ValueString()), usr.client.Options(ctx)...)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to read user %s, got error: %s", config.ID.ValueString(), err),
+			)
+			return
+		}
+		user = res
+
+	case !config.Name.IsNull() || !config.Email.IsNull():
+		users, err := getUsers(ctx, usr.client, userFilters{
+			Name:  config.Name,
+			Email: config.Email,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read CTFd Users",
+				err.Error(),
+			)
+			return
+		}
+		if len(users) != 1 {
+			resp.Diagnostics.AddError(
+				"Invalid User Lookup",
+				fmt.Sprintf("Expected a single user to match, got %d.", len(users)),
+			)
+			return
+		}
+		user = users[0]
+
+	default:
+		resp.Diagnostics.AddError(
+			"Invalid User Lookup",
+			"Either id, name or email must be set to look up a user.",
+		)
+		return
+	}
+
+	state := flattenUser(user)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
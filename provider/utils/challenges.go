@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ctfer-io/go-ctfd/api"
+)
+
+// PostChallenges creates a challenge in CTFd.
+// It replaces api.Client.PostChallenges to merge the extra attributes
+// of challenge types provided by plugins into the request, which take
+// precedence over params.
+func PostChallenges(client *Client, params *api.PostChallengesParams, extra map[string]any, opts ...api.Option) (*api.Challenge, error) {
+	body, err := mergeParams(params, extra)
+	if err != nil {
+		return nil, err
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, "/challenges", body)
+	req.Header.Set("Content-Type", "application/json")
+
+	chall := &api.Challenge{}
+	if err := client.Call(req, chall, opts...); err != nil {
+		return nil, err
+	}
+	return chall, nil
+}
+
+// PatchChallenge updates a challenge in CTFd.
+// It replaces api.Client.PatchChallenge to merge the extra attributes
+// of challenge types provided by plugins into the request, which take
+// precedence over params.
+func PatchChallenge(client *Client, id int, params *api.PatchChallengeParams, extra map[string]any, opts ...api.Option) (*api.Challenge, error) {
+	body, err := mergeParams(params, extra)
+	if err != nil {
+		return nil, err
+	}
+
+	req, _ := http.NewRequest(http.MethodPatch, fmt.Sprintf("/challenges/%d", id), body)
+	req.Header.Set("Content-Type", "application/json")
+
+	chall := &api.Challenge{}
+	if err := client.Call(req, chall, opts...); err != nil {
+		return nil, err
+	}
+	return chall, nil
+}
+
+// GetChallengeAttributes returns all the attributes of a challenge as
+// returned by CTFd, including the ones specific to its type that
+// api.Challenge does not know.
+func GetChallengeAttributes(client *Client, id int, opts ...api.Option) (map[string]any, error) {
+	req, _ := http.NewRequest(http.MethodGet, fmt.Sprintf("/challenges/%d", id), nil)
+
+	attrs := map[string]any{}
+	if err := client.Call(req, &attrs, opts...); err != nil {
+		return nil, err
+	}
+	return attrs, nil
+}
+
+func mergeParams(params any, extra map[string]any) (*bytes.Buffer, error) {
+	b, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	merged := map[string]any{}
+	if err := json.Unmarshal(b, &merged); err != nil {
+		return nil, err
+	}
+	for k, v := range extra {
+		merged[k] = v
+	}
+
+	b, err = json.Marshal(merged)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewBuffer(b), nil
+}
@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"context"
+	"maps"
+	"net/http"
+	"sync"
+
+	"github.com/ctfer-io/go-ctfd/api"
+)
+
+// Client wraps the CTFd API client with the transport every call
+// should go through, shared across resources and data sources.
+type Client struct {
+	*api.Client
+
+	transport http.RoundTripper
+
+	// Requirements planned for the challenges managed with this client.
+	Requirements *PlannedRequirements
+}
+
+func NewClient(client *api.Client, transport http.RoundTripper) *Client {
+	return &Client{
+		Client:    client,
+		transport: transport,
+		Requirements: &PlannedRequirements{
+			preqs: map[int][]int{},
+		},
+	}
+}
+
+// PlannedRequirements records the prerequisites planned for challenges,
+// such that the requirements graph is checked as it will be once applied
+// rather than as it is in CTFd, across all the challenges planned
+// together.
+type PlannedRequirements struct {
+	mx    sync.Mutex
+	preqs map[int][]int
+}
+
+// Plan records the prerequisites planned for the challenge id, then
+// returns a copy of all those planned so far.
+// As both happen at once, out of two challenges planned concurrently
+// the latter always sees the former.
+func (p *PlannedRequirements) Plan(id int, preqs []int) map[int][]int {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	p.preqs[id] = preqs
+	return maps.Clone(p.preqs)
+}
+
+// Options returns the options to issue a call to CTFd with.
+func (client *Client) Options(ctx context.Context) []api.Option {
+	return []api.Option{
+		api.WithContext(ctx),
+		api.WithTransport(client.transport),
+	}
+}
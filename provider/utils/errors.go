@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// NotFoundError is returned by calls to CTFd when the requested
+// object does not exist (e.g. it was deleted outside of Terraform).
+type NotFoundError struct {
+	Method string
+	URL    string
+}
+
+func (err *NotFoundError) Error() string {
+	return fmt.Sprintf("CTFd responded with status code %d on %s %s", http.StatusNotFound, err.Method, err.URL)
+}
+
+// IsNotFound returns whether err is, or wraps, a *NotFoundError.
+func IsNotFound(err error) bool {
+	var nf *NotFoundError
+	return errors.As(err, &nf)
+}
+
+// NotFoundTransport turns CTFd 404 responses into a *NotFoundError,
+// as go-ctfd does not expose the status code of a failed call.
+type NotFoundTransport struct {
+	Base http.RoundTripper
+}
+
+var _ http.RoundTripper = (*NotFoundTransport)(nil)
+
+func (t *NotFoundTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := t.Base.RoundTrip(req)
+	if err != nil || res.StatusCode != http.StatusNotFound {
+		return res, err
+	}
+	_, _ = io.Copy(io.Discard, res.Body)
+	_ = res.Body.Close()
+	return nil, &NotFoundError{
+		Method: req.Method,
+		URL:    req.URL.String(),
+	}
+}
@@ -0,0 +1,58 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/ctfer-io/go-ctfd/api"
+)
+
+func Test_U_IsNotFound(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/challenges/1":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"success": true, "data": {"id": 1, "name": "chall"}}`))
+		case "/api/v1/challenges/2":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+		}
+	}))
+	defer srv.Close()
+
+	client := NewClient(api.NewClient(srv.URL, "", "", "key"), &NotFoundTransport{
+		Base: http.DefaultTransport,
+	})
+	ctx := context.Background()
+
+	// Existing object
+	if _, err := client.GetChallenge(1, client.Options(ctx)...); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Deleted object, as wrapped by go-ctfd then net/http
+	_, err := client.GetChallenge(3, client.Options(ctx)...)
+	if !IsNotFound(err) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+	var uerr *url.Error
+	if !errors.As(err, &uerr) {
+		t.Fatalf("expected the error to be wrapped in a *url.Error, got %T", err)
+	}
+	var nf *NotFoundError
+	_ = errors.As(err, &nf)
+	if nf.Method != http.MethodGet || nf.URL != srv.URL+"/api/v1/challenges/3" {
+		t.Fatalf("unexpected not found error: %+v", nf)
+	}
+
+	// Other failures are not
+	if _, err := client.GetChallenge(2, client.Options(ctx)...); err == nil || IsNotFound(err) {
+		t.Fatalf("expected an error other than not found, got %v", err)
+	}
+}
@@ -0,0 +1,158 @@
+package utils
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PlanFileDigests plans the sha256 and size of the files listed at p
+// from their source, such that a content change produces a diff.
+// Each source is read, or archived, once per plan.
+func PlanFileDigests(ctx context.Context, plan *tfsdk.Plan, p path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var files types.List
+	diags.Append(plan.GetAttribute(ctx, p, &files)...)
+	if diags.HasError() || files.IsNull() || files.IsUnknown() {
+		return diags
+	}
+
+	for i := range files.Elements() {
+		fp := p.AtListIndex(i)
+
+		// Only the source attributes are read, as the owners of the
+		// files extend them with their own
+		var file FileSubresourceModel
+		for name, dst := range map[string]*types.String{
+			"name":           &file.Name,
+			"path":           &file.Path,
+			"content":        &file.Content,
+			"content_base64": &file.ContentBase64,
+			"source_dir":     &file.SourceDir,
+		} {
+			diags.Append(plan.GetAttribute(ctx, fp.AtName(name), dst)...)
+		}
+		if diags.HasError() {
+			return diags
+		}
+
+		sum, size, ok, err := fileDigest(file)
+		if err != nil {
+			diags.AddAttributeError(fp, "File Read Error", err.Error())
+			continue
+		}
+		if !ok {
+			continue
+		}
+		diags.Append(plan.SetAttribute(ctx, fp.AtName("sha256"), types.StringValue(sum))...)
+		diags.Append(plan.SetAttribute(ctx, fp.AtName("size"), types.Int64Value(size))...)
+	}
+	return diags
+}
+
+// PlanUnchangedFiles plans the computed attributes of the files listed
+// at p that are unchanged from state, as SyncFilesOnUpdate keeps them,
+// such that they are not unknown at each change of the list.
+// It must run once the digests are planned by PlanFileDigests.
+func PlanUnchangedFiles(ctx context.Context, state tfsdk.State, plan *tfsdk.Plan, p path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if state.Raw.IsNull() {
+		return diags
+	}
+
+	var planFiles, stateFiles types.List
+	diags.Append(plan.GetAttribute(ctx, p, &planFiles)...)
+	diags.Append(state.GetAttribute(ctx, p, &stateFiles)...)
+	if diags.HasError() || planFiles.IsNull() || planFiles.IsUnknown() || stateFiles.IsNull() {
+		return diags
+	}
+
+	// Files are matched by name, as a logical key
+	stateByName := map[string]types.Object{}
+	for _, elem := range stateFiles.Elements() {
+		if obj, ok := elem.(types.Object); ok {
+			stateByName[fileFromAttributes(obj).Name.ValueString()] = obj
+		}
+	}
+
+	for i, elem := range planFiles.Elements() {
+		planObj, ok := elem.(types.Object)
+		if !ok || planObj.IsNull() || planObj.IsUnknown() {
+			continue
+		}
+		planFile := fileFromAttributes(planObj)
+		if planFile.Name.IsUnknown() {
+			continue
+		}
+		stateObj, ok := stateByName[planFile.Name.ValueString()]
+		if !ok {
+			continue
+		}
+		stateFile := fileFromAttributes(stateObj)
+		if stateFile.ID.IsNull() || !sameFile(stateFile, planFile) {
+			continue
+		}
+
+		// Only the unknown ones are planned, as the owners of the files
+		// extend them with their own
+		attrs := planObj.Attributes()
+		stateAttrs := stateObj.Attributes()
+		for name, value := range attrs {
+			if value.IsUnknown() {
+				attrs[name] = stateAttrs[name]
+			}
+		}
+		obj, objDiags := types.ObjectValue(planObj.AttributeTypes(ctx), attrs)
+		diags.Append(objDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		diags.Append(plan.SetAttribute(ctx, p.AtListIndex(i), obj)...)
+	}
+	return diags
+}
+
+// fileFromAttributes returns the attributes of a file object that
+// sameFile compares.
+func fileFromAttributes(obj types.Object) FileSubresourceModel {
+	attrs := obj.Attributes()
+	str := func(name string) types.String {
+		v, _ := attrs[name].(types.String)
+		return v
+	}
+	id, _ := attrs["id"].(types.Int64)
+	return FileSubresourceModel{
+		ID:            id,
+		Name:          str("name"),
+		Path:          str("path"),
+		Content:       str("content"),
+		ContentBase64: str("content_base64"),
+		SourceDir:     str("source_dir"),
+		Location:      str("location"),
+		SHA256:        str("sha256"),
+	}
+}
+
+// fileDigest returns the SHA-256 and size of the content of file.
+// If its source is not known yet, or does not exist yet (e.g. it is built
+// during apply), ok is false and the values are left unknown until the
+// upload.
+func fileDigest(file FileSubresourceModel) (sum string, size int64, ok bool, err error) {
+	content, ok, err := FileSource(file)
+	if err != nil || !ok {
+		return "", 0, false, err
+	}
+	sum, size = contentDigest(content)
+	return sum, size, true, nil
+}
+
+func contentDigest(content []byte) (string, int64) {
+	h := sha256.Sum256(content)
+	return hex.EncodeToString(h[:]), int64(len(content))
+}
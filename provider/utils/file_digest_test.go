@@ -0,0 +1,132 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testFilesModel struct {
+	Files []FileSubresourceModel `tfsdk:"files"`
+}
+
+func Test_U_PlanUnchangedFiles(t *testing.T) {
+	var tests = map[string]struct {
+		Plan     FileSubresourceModel
+		Expected bool
+	}{
+		"unchanged": {
+			Plan:     testPlannedFile("a.txt", "a"),
+			Expected: true,
+		},
+		"content-changed": {
+			Plan:     testPlannedFile("a.txt", "b"),
+			Expected: false,
+		},
+		"renamed": {
+			Plan:     testPlannedFile("b.txt", "a"),
+			Expected: false,
+		},
+		"location-unchanged": {
+			Plan: func() FileSubresourceModel {
+				file := testPlannedFile("a.txt", "a")
+				file.Location = types.StringValue("abc/a.txt")
+				return file
+			}(),
+			Expected: true,
+		},
+		"location-changed": {
+			Plan: func() FileSubresourceModel {
+				file := testPlannedFile("a.txt", "a")
+				file.Location = types.StringValue("def/a.txt")
+				return file
+			}(),
+			Expected: false,
+		},
+	}
+
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"files": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: FileAttributes(),
+				},
+			},
+		},
+	}
+
+	sum, size := contentDigest([]byte("a"))
+	stored := FileSubresourceModel{
+		ID:            types.Int64Value(1),
+		Name:          types.StringValue("a.txt"),
+		Path:          types.StringNull(),
+		Content:       types.StringValue("a"),
+		ContentBase64: types.StringNull(),
+		SourceDir:     types.StringNull(),
+		Type:          types.StringValue("standard"),
+		Location:      types.StringValue("abc/a.txt"),
+		URL:           types.StringValue("/files/abc/a.txt"),
+		SHA256:        types.StringValue(sum),
+		Size:          types.Int64Value(size),
+		SHA1Sum:       types.StringValue("86f7e437faa5a7fce15d1ddcb9eaeaea377667b8"),
+	}
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	if diags := state.Set(ctx, testFilesModel{Files: []FileSubresourceModel{stored}}); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	for testname, tt := range tests {
+		t.Run(testname, func(t *testing.T) {
+			plan := tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+			if diags := plan.Set(ctx, testFilesModel{Files: []FileSubresourceModel{tt.Plan}}); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			diags := PlanFileDigests(ctx, &plan, path.Root("files"))
+			diags.Append(PlanUnchangedFiles(ctx, state, &plan, path.Root("files"))...)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			var got testFilesModel
+			if diags := plan.Get(ctx, &got); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			file := got.Files[0]
+			if !tt.Expected {
+				if !file.ID.IsUnknown() || !file.URL.IsUnknown() {
+					t.Fatalf("expected the file to be uploaded again, got %v", file)
+				}
+				return
+			}
+			if !file.ID.Equal(stored.ID) || !file.Type.Equal(stored.Type) || !file.Location.Equal(stored.Location) ||
+				!file.URL.Equal(stored.URL) || !file.SHA1Sum.Equal(stored.SHA1Sum) {
+				t.Fatalf("expected the file to be kept as %v, got %v", stored, file)
+			}
+		})
+	}
+}
+
+func testPlannedFile(name, content string) FileSubresourceModel {
+	return FileSubresourceModel{
+		ID:            types.Int64Unknown(),
+		Name:          types.StringValue(name),
+		Path:          types.StringNull(),
+		Content:       types.StringValue(content),
+		ContentBase64: types.StringNull(),
+		SourceDir:     types.StringNull(),
+		Type:          types.StringUnknown(),
+		Location:      types.StringUnknown(),
+		URL:           types.StringUnknown(),
+		SHA256:        types.StringUnknown(),
+		Size:          types.Int64Unknown(),
+		SHA1Sum:       types.StringUnknown(),
+	}
+}
@@ -0,0 +1,131 @@
+package utils
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// zipEpoch is the modification time of every entry of an archive built
+// from a source_dir, such that it only depends on the files content.
+var zipEpoch = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// zipFileMode is the mode of every entry of an archive built by zipDir.
+const zipFileMode fs.FileMode = 0o644
+
+// FileSource returns the content to upload for a file, from whichever
+// of path, content, content_base64 or source_dir is set.
+// If the source is not known yet, or the local file or directory does
+// not exist yet (e.g. it is built during apply), ok is false.
+func FileSource(file FileSubresourceModel) (content []byte, ok bool, err error) {
+	sources := map[string]types.String{
+		"path":           file.Path,
+		"content":        file.Content,
+		"content_base64": file.ContentBase64,
+		"source_dir":     file.SourceDir,
+	}
+	set := ""
+	for name, src := range sources {
+		if src.IsNull() {
+			continue
+		}
+		if set != "" {
+			return nil, false, fmt.Errorf("file '%s' must only set one of path, content, content_base64 or source_dir", file.Name.ValueString())
+		}
+		set = name
+	}
+	if set == "" {
+		return nil, false, fmt.Errorf("file '%s' must set one of path, content, content_base64 or source_dir", file.Name.ValueString())
+	}
+	if sources[set].IsUnknown() {
+		return nil, false, nil
+	}
+
+	switch set {
+	case "content":
+		return []byte(file.Content.ValueString()), true, nil
+
+	case "content_base64":
+		content, err := base64.StdEncoding.DecodeString(file.ContentBase64.ValueString())
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid content_base64 of file '%s': %w", file.Name.ValueString(), err)
+		}
+		return content, true, nil
+
+	case "source_dir":
+		content, err := zipDir(file.SourceDir.ValueString())
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil, false, nil
+			}
+			return nil, false, fmt.Errorf("unable to archive directory '%s': %w", file.SourceDir.ValueString(), err)
+		}
+		return content, true, nil
+	}
+
+	content, err = os.ReadFile(file.Path.ValueString())
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("unable to read file at path '%s': %w", file.Path.ValueString(), err)
+	}
+	return content, true, nil
+}
+
+// zipDir packs the regular files of dir into a zip archive.
+// Entries are walked in lexical order and get a fixed modification time
+// and mode, so unchanged sources always produce the same archive, whatever
+// the machine, umask or checkout they are archived from.
+func zipDir(dir string) ([]byte, error) {
+	if _, err := os.Stat(dir); err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	w := zip.NewWriter(&b)
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+
+		header := &zip.FileHeader{
+			Name:     filepath.ToSlash(rel),
+			Method:   zip.Deflate,
+			Modified: zipEpoch,
+		}
+		header.SetMode(zipFileMode)
+		fw, err := w.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+		content, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		_, err = fw.Write(content)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
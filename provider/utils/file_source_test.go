@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func Test_U_ZipDir(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"b.txt":        "b",
+		"a.txt":        "a",
+		"sub/c.txt":    "c",
+		"sub/sub/d.sh": "#!/bin/sh",
+	} {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	first, err := zipDir(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Touching the files must not change the archive
+	later := time.Now().Add(time.Hour)
+	if err := os.Chtimes(filepath.Join(dir, "a.txt"), later, later); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 5; i++ {
+		again, err := zipDir(dir)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if !bytes.Equal(first, again) {
+			t.Fatalf("archive %d differs from the first one", i)
+		}
+	}
+
+	// Nor changing their mode, which depends on the umask and checkout
+	if err := os.Chmod(filepath.Join(dir, "sub", "sub", "d.sh"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod(filepath.Join(dir, "b.txt"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	again, err := zipDir(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !bytes.Equal(first, again) {
+		t.Fatal("archive differs with the mode of its files")
+	}
+
+	// Editing a file must change it
+	if err := os.WriteFile(filepath.Join(dir, "sub", "c.txt"), []byte("C"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	edited, err := zipDir(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if bytes.Equal(first, edited) {
+		t.Fatal("archive did not change with its content")
+	}
+}
+
+func Test_U_ZipDirNotExist(t *testing.T) {
+	if _, err := zipDir(filepath.Join(t.TempDir(), "missing")); !os.IsNotExist(err) {
+		t.Fatalf("expected a not exist error, got %v", err)
+	}
+}
@@ -0,0 +1,448 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"path"
+	"strconv"
+
+	"github.com/ctfer-io/go-ctfd/api"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PostFilesParams describes the files to upload, and what they
+// are attached to.
+type PostFilesParams struct {
+	Files []*api.InputFile
+	// Type is the CTFd file type, e.g. "challenge" or "page".
+	Type      string
+	Challenge *int
+	Page      *int
+	Location  *string
+}
+
+// PostFiles uploads files to CTFd.
+// It replaces api.Client.PostFiles that only supports attaching
+// files to challenges.
+func PostFiles(client *Client, params *PostFilesParams, opts ...api.Option) ([]*api.File, error) {
+	var b bytes.Buffer
+	w := multipart.NewWriter(&b)
+
+	fields := map[string]string{
+		"type": params.Type,
+	}
+	if params.Challenge != nil {
+		fields["challenge"] = strconv.Itoa(*params.Challenge)
+	}
+	if params.Page != nil {
+		fields["page_id"] = strconv.Itoa(*params.Page)
+	}
+	if params.Location != nil {
+		fields["location"] = *params.Location
+	}
+	for k, v := range fields {
+		if err := w.WriteField(k, v); err != nil {
+			return nil, err
+		}
+	}
+	for _, file := range params.Files {
+		fw, err := w.CreateFormFile("file", file.Name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := fw.Write(file.Content); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	// Process request directly, as it does not use the REST flow
+	req, _ := http.NewRequest(http.MethodPost, "/files", &b)
+	req.Header.Set("Content-Type", w.FormDataContentType())
+
+	files := []*api.File{}
+	if err := client.Call(req, &files, opts...); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// FileSubresourceModel describes a single file attached to a CTFd
+// object, e.g. a challenge attachment or an image of a page.
+// Its content comes from exactly one of "path" (a local file), "content",
+// "content_base64" or "source_dir" (a local directory packed as a zip).
+// They are only used for upload; CTFd API does not let us read file
+// content back, only metadata such as id/name/location.
+type FileSubresourceModel struct {
+	ID            types.Int64  `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Path          types.String `tfsdk:"path"`
+	Content       types.String `tfsdk:"content"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	SourceDir     types.String `tfsdk:"source_dir"`
+	Type          types.String `tfsdk:"type"`
+	Location      types.String `tfsdk:"location"`
+	URL           types.String `tfsdk:"url"`
+	SHA256        types.String `tfsdk:"sha256"`
+	Size          types.Int64  `tfsdk:"size"`
+	SHA1Sum       types.String `tfsdk:"sha1sum"`
+}
+
+// FileAttributes returns the schema attributes of FileSubresourceModel.
+// Owners of files could extend or override them.
+func FileAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "Identifier of the file in CTFd.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Logical name of the file in CTFd.",
+			Required:            true,
+		},
+		"path": schema.StringAttribute{
+			MarkdownDescription: "Local filesystem path to upload as this file. Conflicts with `content`, `content_base64` and `source_dir`.",
+			Optional:            true,
+			Sensitive:           true,
+		},
+		"content": schema.StringAttribute{
+			MarkdownDescription: "Content of the file, e.g. a rendered configuration. Conflicts with `path`, `content_base64` and `source_dir`.",
+			Optional:            true,
+			Sensitive:           true,
+		},
+		"content_base64": schema.StringAttribute{
+			MarkdownDescription: "Base64-encoded content of the file, for binary content. Conflicts with `path`, `content` and `source_dir`.",
+			Optional:            true,
+			Sensitive:           true,
+		},
+		"source_dir": schema.StringAttribute{
+			MarkdownDescription: "Local directory to pack as a zip archive and upload as this file. The archive is deterministic (fixed entries order, modification time and mode), so unchanged sources do not produce a diff. Conflicts with `path`, `content` and `content_base64`.",
+			Optional:            true,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "Type of the file entry in CTFd.",
+			Computed:            true,
+		},
+		"location": schema.StringAttribute{
+			MarkdownDescription: "Location of the file in CTFd storage, as `<directory>/<filename>`. Generated by CTFd if not set.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"url": schema.StringAttribute{
+			MarkdownDescription: "URL to the file as served by CTFd.",
+			Computed:            true,
+		},
+		"sha256": schema.StringAttribute{
+			MarkdownDescription: "SHA-256 of the file content, computed at plan time from its source. A change re-uploads the file.",
+			Computed:            true,
+		},
+		"size": schema.Int64Attribute{
+			MarkdownDescription: "Size in bytes of the file content, computed at plan time from its source.",
+			Computed:            true,
+		},
+		"sha1sum": schema.StringAttribute{
+			MarkdownDescription: "SHA-1 of the file as stored by CTFd, used to detect changes made outside of Terraform.",
+			Computed:            true,
+		},
+	}
+}
+
+// FileOwner describes what files are attached to.
+// Only one of Challenge or Page is set.
+type FileOwner struct {
+	// Type is the CTFd file type used when a file does not set one,
+	// e.g. "challenge" or "page".
+	Type      string
+	Challenge *int
+	Page      *int
+}
+
+func (owner FileOwner) String() string {
+	if owner.Challenge != nil {
+		return fmt.Sprintf("challenge %d", *owner.Challenge)
+	}
+	return fmt.Sprintf("page %d", *owner.Page)
+}
+
+// files returns the files of owner in CTFd.
+// As CTFd does not list the files of a page, they are fetched one by one
+// from the known ones, thus are only returned if among them.
+func (owner FileOwner) files(ctx context.Context, client *Client, known []FileSubresourceModel) ([]*api.File, error) {
+	if owner.Challenge != nil {
+		return client.GetChallengeFiles(*owner.Challenge, client.Options(ctx)...)
+	}
+
+	files := make([]*api.File, 0, len(known))
+	for _, k := range known {
+		if k.ID.IsNull() || k.ID.IsUnknown() {
+			continue
+		}
+		file, err := client.GetFile(strconv.Itoa(int(k.ID.ValueInt64())), client.Options(ctx)...)
+		if err != nil {
+			if IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// CreateFiles uploads files from plan to CTFd and returns the updated list with IDs.
+func CreateFiles(ctx context.Context, client *Client, owner FileOwner, filesFromPlan []FileSubresourceModel) ([]FileSubresourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	result := make([]FileSubresourceModel, 0, len(filesFromPlan))
+
+	for _, fileModel := range filesFromPlan {
+		// Resolve file content from its source
+		fileContent, ok, err := FileSource(fileModel)
+		if err != nil {
+			diags.AddError(
+				"File Read Error",
+				err.Error(),
+			)
+			continue
+		}
+		if !ok {
+			diags.AddError(
+				"File Read Error",
+				fmt.Sprintf("Unable to find the source of file '%s', it must exist at apply time", fileModel.Name.ValueString()),
+			)
+			continue
+		}
+
+		// Upload file to CTFd
+		fileType := owner.Type
+		if !fileModel.Type.IsNull() && !fileModel.Type.IsUnknown() {
+			fileType = fileModel.Type.ValueString()
+		}
+		var location *string
+		if !fileModel.Location.IsNull() && !fileModel.Location.IsUnknown() {
+			location = fileModel.Location.ValueStringPointer()
+		}
+
+		fileName := fileModel.Name.ValueString()
+		uploadedFiles, err := PostFiles(client, &PostFilesParams{
+			Files: []*api.InputFile{
+				{
+					Name:    fileName,
+					Content: fileContent,
+				},
+			},
+			Type:      fileType,
+			Challenge: owner.Challenge,
+			Page:      owner.Page,
+			Location:  location,
+		}, client.Options(ctx)...)
+		if err != nil {
+			diags.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to upload file '%s' for %s: %s", fileName, owner, err),
+			)
+			continue
+		}
+
+		// CTFd API returns a list of uploaded files; we expect one file per call
+		if len(uploadedFiles) == 0 {
+			diags.AddError(
+				"Unexpected API Response",
+				fmt.Sprintf("No file returned after upload for '%s'", fileName),
+			)
+			continue
+		}
+
+		uploaded := uploadedFiles[0]
+		sum, size := contentDigest(fileContent)
+
+		// Build the result model with computed fields
+		result = append(result, FileSubresourceModel{
+			ID:            types.Int64Value(int64(uploaded.ID)),
+			Name:          types.StringValue(fileName),
+			Path:          fileModel.Path,
+			Content:       fileModel.Content,
+			ContentBase64: fileModel.ContentBase64,
+			SourceDir:     fileModel.SourceDir,
+			Type:          types.StringValue(uploaded.Type),
+			Location:      types.StringValue(uploaded.Location),
+			URL:           types.StringValue(fmt.Sprintf("/files/%s", uploaded.Location)),
+			SHA256:        types.StringValue(sum),
+			Size:          types.Int64Value(size),
+			SHA1Sum:       types.StringValue(uploaded.SHA1sum),
+		})
+	}
+
+	return result, diags
+}
+
+// ReadFiles retrieves file metadata from CTFd for a given owner.
+// Files are matched by ID with priorFiles to keep their logical name and
+// source, as CTFd can't return them, then the ones unknown from prior
+// state (e.g. uploaded through the web UI, or on import) are appended
+// named after their location.
+// A file deleted outside of Terraform is dropped, and one which content
+// changed in CTFd gets an empty sha256, both leading to a re-upload on
+// next apply.
+// If priorFiles is nil, the files are not managed thus are not read.
+func ReadFiles(ctx context.Context, client *Client, owner FileOwner, priorFiles []FileSubresourceModel) ([]FileSubresourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if priorFiles == nil {
+		return nil, diags
+	}
+
+	files, err := owner.files(ctx, client, priorFiles)
+	if err != nil {
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read files for %s: %s", owner, err),
+		)
+		return nil, diags
+	}
+	byID := make(map[int64]*api.File, len(files))
+	for _, file := range files {
+		byID[int64(file.ID)] = file
+	}
+
+	result := make([]FileSubresourceModel, 0, len(files))
+	for _, prior := range priorFiles {
+		file, ok := byID[prior.ID.ValueInt64()]
+		if prior.ID.IsNull() || prior.ID.IsUnknown() || !ok {
+			// Deleted outside of Terraform
+			continue
+		}
+		delete(byID, prior.ID.ValueInt64())
+
+		prior.Type = types.StringValue(file.Type)
+		prior.Location = types.StringValue(file.Location)
+		prior.URL = types.StringValue(fmt.Sprintf("/files/%s", file.Location))
+		if file.SHA1sum != "" {
+			if !prior.SHA1Sum.IsNull() && prior.SHA1Sum.ValueString() != file.SHA1sum {
+				// Content changed outside of Terraform
+				prior.SHA256 = types.StringValue("")
+			}
+			prior.SHA1Sum = types.StringValue(file.SHA1sum)
+		}
+		result = append(result, prior)
+	}
+	for _, file := range files {
+		if _, ok := byID[int64(file.ID)]; !ok {
+			continue
+		}
+		result = append(result, FileSubresourceModel{
+			ID:            types.Int64Value(int64(file.ID)),
+			Name:          types.StringValue(path.Base(file.Location)),
+			Path:          types.StringNull(), // We cannot read back the original source
+			Content:       types.StringNull(),
+			ContentBase64: types.StringNull(),
+			SourceDir:     types.StringNull(),
+			Type:          types.StringValue(file.Type),
+			Location:      types.StringValue(file.Location),
+			URL:           types.StringValue(fmt.Sprintf("/files/%s", file.Location)),
+			SHA256:        types.StringNull(),
+			Size:          types.Int64Null(),
+			SHA1Sum:       ToTFString(nonEmpty(file.SHA1sum)),
+		})
+	}
+
+	return result, diags
+}
+
+// SyncFilesOnUpdate handles file updates by deleting removed files and uploading new ones.
+func SyncFilesOnUpdate(ctx context.Context, client *Client, owner FileOwner, oldFiles, newFiles []FileSubresourceModel) ([]FileSubresourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Build maps for comparison (by name, as a logical key)
+	oldByName := make(map[string]FileSubresourceModel)
+	for _, f := range oldFiles {
+		oldByName[f.Name.ValueString()] = f
+	}
+
+	newByName := make(map[string]FileSubresourceModel)
+	for _, f := range newFiles {
+		newByName[f.Name.ValueString()] = f
+	}
+
+	// Delete files that are no longer in the new config
+	for name, oldFile := range oldByName {
+		if _, exists := newByName[name]; !exists {
+			// File removed, delete it
+			if !oldFile.ID.IsNull() {
+				if err := client.DeleteFile(strconv.Itoa(int(oldFile.ID.ValueInt64())), client.Options(ctx)...); err != nil {
+					diags.AddWarning(
+						"File Delete Warning",
+						fmt.Sprintf("Unable to delete file '%s' (ID: %d): %s", name, oldFile.ID.ValueInt64(), err),
+					)
+				}
+			}
+		}
+	}
+
+	// Upload new files (files that don't have an ID or have changed source or content)
+	result := make([]FileSubresourceModel, 0, len(newFiles))
+	for _, newFile := range newFiles {
+		oldFile, existedBefore := oldByName[newFile.Name.ValueString()]
+
+		// If the file existed and has the same source, keep it
+		if existedBefore && !oldFile.ID.IsNull() {
+			if sameFile(oldFile, newFile) {
+				// Source and content unchanged, reuse old file
+				result = append(result, oldFile)
+				continue
+			}
+
+			// Source or content changed: delete old, upload new
+			if err := client.DeleteFile(strconv.Itoa(int(oldFile.ID.ValueInt64())), client.Options(ctx)...); err != nil {
+				diags.AddWarning(
+					"File Delete Warning",
+					fmt.Sprintf("Unable to delete old version of file '%s' (ID: %d): %s", newFile.Name.ValueString(), oldFile.ID.ValueInt64(), err),
+				)
+			}
+		}
+
+		// Upload the new file
+		uploaded, uploadDiags := CreateFiles(ctx, client, owner, []FileSubresourceModel{newFile})
+		diags.Append(uploadDiags...)
+		if len(uploaded) > 0 {
+			result = append(result, uploaded[0])
+		}
+	}
+
+	if newFiles == nil && len(result) == 0 {
+		return nil, diags
+	}
+	return result, diags
+}
+
+func nonEmpty(str string) *string {
+	if str == "" {
+		return nil
+	}
+	return &str
+}
+
+// sameFile returns whether the new file is the old one, unchanged, thus
+// could be kept as is.
+func sameFile(old, new FileSubresourceModel) bool {
+	return sameFileSource(old, new) &&
+		new.SHA256.Equal(old.SHA256) &&
+		(new.Location.IsUnknown() || new.Location.Equal(old.Location))
+}
+
+// sameFileSource returns whether both files are uploaded from the same
+// source. A file without any source (e.g. imported) never matches.
+func sameFileSource(a, b FileSubresourceModel) bool {
+	if a.Path.IsNull() && a.Content.IsNull() && a.ContentBase64.IsNull() && a.SourceDir.IsNull() {
+		return false
+	}
+	return a.Path.Equal(b.Path) &&
+		a.Content.Equal(b.Content) &&
+		a.ContentBase64.Equal(b.ContentBase64) &&
+		a.SourceDir.Equal(b.SourceDir)
+}
@@ -0,0 +1,32 @@
+package utils
+
+import "net/http"
+
+// HeaderTransport injects headers in every request, e.g. to pass
+// through an access gateway in front of CTFd.
+type HeaderTransport struct {
+	Base    http.RoundTripper
+	Headers map[string]string
+
+	// Username and Password, if set, are sent as HTTP basic auth.
+	Username string
+	Password string
+}
+
+var _ http.RoundTripper = (*HeaderTransport)(nil)
+
+func (t *HeaderTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if len(t.Headers) == 0 && t.Username == "" {
+		return t.Base.RoundTrip(req)
+	}
+
+	// A RoundTripper must not modify the request
+	req = req.Clone(req.Context())
+	for k, v := range t.Headers {
+		req.Header.Set(k, v)
+	}
+	if t.Username != "" {
+		req.SetBasicAuth(t.Username, t.Password)
+	}
+	return t.Base.RoundTrip(req)
+}
@@ -0,0 +1,99 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/ctfer-io/go-ctfd/api"
+)
+
+// MaxPerPage is the maximum number of rows CTFd returns per page.
+const MaxPerPage = 100
+
+// Pagination holds the query parameters of a paginated CTFd listing,
+// to embed in its parameters.
+type Pagination struct {
+	Page    int `schema:"page,omitempty"`
+	PerPage int `schema:"per_page,omitempty"`
+}
+
+func (p *Pagination) pagination() *Pagination {
+	return p
+}
+
+// Paginated is implemented by the parameters embedding Pagination.
+type Paginated interface {
+	pagination() *Pagination
+}
+
+// GetPages lists all the rows of a paginated CTFd listing, following
+// its pagination until there is no next page.
+func GetPages[T any](ctx context.Context, client *Client, edp string, params Paginated) ([]T, error) {
+	p := params.pagination()
+	p.Page = 1
+	p.PerPage = MaxPerPage
+
+	rows := []T{}
+	for {
+		meta := &paginationMeta{}
+		pctx := context.WithValue(ctx, paginationKey{}, meta)
+
+		page := []T{}
+		if err := client.Get(edp, params, &page, api.WithContext(pctx), api.WithTransport(&paginationTransport{
+			Base: client.transport,
+		})); err != nil {
+			return nil, err
+		}
+		rows = append(rows, page...)
+
+		// Stop if the listing is not paginated, or would loop
+		next := meta.Pagination.Next
+		if next == nil || *next <= p.Page {
+			return rows, nil
+		}
+		p.Page = *next
+	}
+}
+
+type paginationKey struct{}
+
+type paginationMeta struct {
+	Pagination struct {
+		Next *int `json:"next"`
+	} `json:"pagination"`
+}
+
+// paginationTransport records the pagination of a CTFd listing in the
+// *paginationMeta of the request context, as go-ctfd drops it.
+// It holds no state, such that it is safe to share between calls.
+type paginationTransport struct {
+	Base http.RoundTripper
+}
+
+var _ http.RoundTripper = (*paginationTransport)(nil)
+
+func (t *paginationTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := t.Base.RoundTrip(req)
+	meta, ok := req.Context().Value(paginationKey{}).(*paginationMeta)
+	if err != nil || !ok || res.StatusCode != http.StatusOK {
+		return res, err
+	}
+
+	body, err := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	var content struct {
+		Meta paginationMeta `json:"meta"`
+	}
+	if err := json.Unmarshal(body, &content); err == nil {
+		*meta = content.Meta
+	}
+	return res, nil
+}
@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/ctfer-io/go-ctfd/api"
+)
+
+type getTestParams struct {
+	Q string `schema:"q,omitempty"`
+
+	Pagination
+}
+
+func Test_U_GetPages(t *testing.T) {
+	// 3 pages of 2 rows
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("q") != "ctf" || r.URL.Query().Get("per_page") != strconv.Itoa(MaxPerPage) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		var next *int
+		if page < 3 {
+			next = Ptr(page + 1)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"success": true,
+			"meta": map[string]any{
+				"pagination": map[string]any{
+					"page": page,
+					"next": next,
+				},
+			},
+			"data": []map[string]any{
+				{"id": 2*page - 1},
+				{"id": 2 * page},
+			},
+		})
+	}))
+	defer srv.Close()
+
+	client := NewClient(api.NewClient(srv.URL, "", "", "key"), http.DefaultTransport)
+
+	users, err := GetPages[*api.User](context.Background(), client, "/users", &getTestParams{Q: "ctf"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	ids := []int{}
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	if expected := []int{1, 2, 3, 4, 5, 6}; !reflect.DeepEqual(ids, expected) {
+		t.Fatalf("expected users %v, got %v", expected, ids)
+	}
+}
+
+func Test_U_GetPagesNotPaginated(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success": true, "data": [{"id": 1}]}`))
+	}))
+	defer srv.Close()
+
+	client := NewClient(api.NewClient(srv.URL, "", "", "key"), http.DefaultTransport)
+
+	users, err := GetPages[*api.User](context.Background(), client, "/users", &getTestParams{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(users) != 1 {
+		t.Fatalf("expected 1 user, got %d", len(users))
+	}
+}
@@ -0,0 +1,149 @@
+package utils
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// retries counts the retried requests, as otelhttp only measures each
+// attempt on its own.
+var retries, _ = otel.Meter(instrumentationName).Int64Counter(
+	"ctfd.client.request.retries",
+	metric.WithDescription("Number of CTFd requests retried on a transient error."),
+	metric.WithUnit("{retry}"),
+)
+
+// RetryTransport retries requests that failed on a transient error,
+// e.g. when hitting the CTFd ratelimiter under high parallelism.
+//
+// A 429 is retried for any method, as the request was rejected before
+// being processed. Other transient errors are only retried for
+// idempotent methods, to avoid creating an object twice.
+type RetryTransport struct {
+	Base       http.RoundTripper
+	MaxRetries int
+	MinWait    time.Duration
+	MaxWait    time.Duration
+}
+
+var _ http.RoundTripper = (*RetryTransport)(nil)
+
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	for attempt := 0; ; attempt++ {
+		try := req
+		if attempt > 0 && req.Body != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			try = req.Clone(ctx)
+			try.Body = body
+		}
+
+		res, err := t.Base.RoundTrip(try)
+		if attempt >= t.MaxRetries || !retryable(req, res, err) || (req.Body != nil && req.GetBody == nil) {
+			return res, err
+		}
+
+		wait := t.backoff(attempt, res)
+		fields := map[string]any{
+			"method":  req.Method,
+			"url":     req.URL.String(),
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+		}
+		attrs := []attribute.KeyValue{
+			attribute.String("http.request.method", req.Method),
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status"] = res.StatusCode
+			attrs = append(attrs, attribute.Int("http.response.status_code", res.StatusCode))
+			// Drain the body to reuse the connection
+			_, _ = io.Copy(io.Discard, res.Body)
+			_ = res.Body.Close()
+		}
+		tflog.Debug(ctx, "Retrying CTFd request", fields)
+		retries.Add(ctx, 1, metric.WithAttributes(attrs...))
+
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// backoff returns how long to wait before the next attempt.
+// It respects the Retry-After header if any, up to MaxWait, else grows
+// exponentially between MinWait and MaxWait, with jitter.
+func (t *RetryTransport) backoff(attempt int, res *http.Response) time.Duration {
+	if res != nil {
+		if ra := res.Header.Get("Retry-After"); ra != "" {
+			if secs, err := strconv.Atoi(ra); err == nil && secs >= 0 {
+				// Compared in seconds to avoid overflowing on huge values
+				if time.Duration(secs) > t.MaxWait/time.Second {
+					return t.MaxWait
+				}
+				return time.Duration(secs) * time.Second
+			}
+			if date, err := http.ParseTime(ra); err == nil {
+				return min(max(time.Until(date), 0), t.MaxWait)
+			}
+		}
+	}
+
+	wait := t.MinWait << attempt
+	if wait <= 0 || wait > t.MaxWait {
+		wait = t.MaxWait
+	}
+	if wait <= t.MinWait {
+		return wait
+	}
+	return t.MinWait + rand.N(wait-t.MinWait)
+}
+
+func retryable(req *http.Request, res *http.Response, err error) bool {
+	if err != nil {
+		// Don't retry on cancellation or deadline
+		if req.Context().Err() != nil {
+			return false
+		}
+		return idempotent(req.Method)
+	}
+	switch res.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return idempotent(req.Method)
+	}
+	return false
+}
+
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
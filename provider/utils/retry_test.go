@@ -0,0 +1,189 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_U_Backoff(t *testing.T) {
+	tr := &RetryTransport{
+		MinWait: time.Second,
+		MaxWait: 30 * time.Second,
+	}
+
+	var tests = map[string]struct {
+		Attempt    int
+		RetryAfter string
+		Min, Max   time.Duration
+	}{
+		"retry-after-seconds": {
+			RetryAfter: "2",
+			Min:        2 * time.Second,
+			Max:        2 * time.Second,
+		},
+		"retry-after-capped": {
+			RetryAfter: "86400",
+			Min:        30 * time.Second,
+			Max:        30 * time.Second,
+		},
+		"retry-after-overflow": {
+			RetryAfter: "9223372036854775807",
+			Min:        30 * time.Second,
+			Max:        30 * time.Second,
+		},
+		"retry-after-date-capped": {
+			RetryAfter: time.Now().Add(24 * time.Hour).UTC().Format(http.TimeFormat),
+			Min:        30 * time.Second,
+			Max:        30 * time.Second,
+		},
+		"retry-after-date-past": {
+			RetryAfter: time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat),
+			Min:        0,
+			Max:        0,
+		},
+		"retry-after-invalid": {
+			RetryAfter: "soon",
+			Min:        time.Second,
+			Max:        time.Second,
+		},
+		"first-attempt": {
+			Attempt: 0,
+			Min:     time.Second,
+			Max:     time.Second,
+		},
+		"exponential": {
+			Attempt: 2,
+			Min:     time.Second,
+			Max:     4 * time.Second,
+		},
+		"max-wait": {
+			Attempt: 10,
+			Min:     time.Second,
+			Max:     30 * time.Second,
+		},
+		"shift-overflow": {
+			Attempt: 100,
+			Min:     time.Second,
+			Max:     30 * time.Second,
+		},
+	}
+
+	for testname, tt := range tests {
+		t.Run(testname, func(t *testing.T) {
+			res := &http.Response{Header: http.Header{}}
+			if tt.RetryAfter != "" {
+				res.Header.Set("Retry-After", tt.RetryAfter)
+			}
+
+			wait := tr.backoff(tt.Attempt, res)
+			if wait < tt.Min || wait > tt.Max {
+				t.Fatalf("expected a wait between %s and %s, got %s", tt.Min, tt.Max, wait)
+			}
+		})
+	}
+}
+
+func Test_U_Retryable(t *testing.T) {
+	errNetwork := errors.New("connection reset by peer")
+
+	var tests = map[string]struct {
+		Method string
+		Status int
+		Err    error
+		Expect bool
+	}{
+		"get-429":         {Method: http.MethodGet, Status: http.StatusTooManyRequests, Expect: true},
+		"post-429":        {Method: http.MethodPost, Status: http.StatusTooManyRequests, Expect: true},
+		"get-502":         {Method: http.MethodGet, Status: http.StatusBadGateway, Expect: true},
+		"get-503":         {Method: http.MethodGet, Status: http.StatusServiceUnavailable, Expect: true},
+		"delete-504":      {Method: http.MethodDelete, Status: http.StatusGatewayTimeout, Expect: true},
+		"post-502":        {Method: http.MethodPost, Status: http.StatusBadGateway, Expect: false},
+		"post-503":        {Method: http.MethodPost, Status: http.StatusServiceUnavailable, Expect: false},
+		"post-504":        {Method: http.MethodPost, Status: http.StatusGatewayTimeout, Expect: false},
+		"patch-503":       {Method: http.MethodPatch, Status: http.StatusServiceUnavailable, Expect: false},
+		"get-500":         {Method: http.MethodGet, Status: http.StatusInternalServerError, Expect: false},
+		"get-404":         {Method: http.MethodGet, Status: http.StatusNotFound, Expect: false},
+		"get-200":         {Method: http.MethodGet, Status: http.StatusOK, Expect: false},
+		"get-network":     {Method: http.MethodGet, Err: errNetwork, Expect: true},
+		"post-network":    {Method: http.MethodPost, Err: errNetwork, Expect: false},
+		"patch-network":   {Method: http.MethodPatch, Err: errNetwork, Expect: false},
+		"delete-network":  {Method: http.MethodDelete, Err: errNetwork, Expect: true},
+		"options-network": {Method: http.MethodOptions, Err: errNetwork, Expect: true},
+	}
+
+	for testname, tt := range tests {
+		t.Run(testname, func(t *testing.T) {
+			req, _ := http.NewRequest(tt.Method, "http://ctfd.local/api/v1/challenges", nil)
+			var res *http.Response
+			if tt.Err == nil {
+				res = &http.Response{StatusCode: tt.Status}
+			}
+
+			if got := retryable(req, res, tt.Err); got != tt.Expect {
+				t.Fatalf("expected %t, got %t", tt.Expect, got)
+			}
+		})
+	}
+}
+
+func Test_U_RetryableCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "http://ctfd.local/api/v1/challenges", nil)
+
+	if retryable(req, nil, context.Canceled) {
+		t.Fatal("expected a canceled request not to be retried")
+	}
+}
+
+func Test_U_RetryTransport(t *testing.T) {
+	var tests = map[string]struct {
+		Method string
+		Status int
+		Hits   int
+	}{
+		"get-503-retried":      {Method: http.MethodGet, Status: http.StatusServiceUnavailable, Hits: 3},
+		"post-429-retried":     {Method: http.MethodPost, Status: http.StatusTooManyRequests, Hits: 3},
+		"post-502-not-retried": {Method: http.MethodPost, Status: http.StatusBadGateway, Hits: 1},
+		"post-503-not-retried": {Method: http.MethodPost, Status: http.StatusServiceUnavailable, Hits: 1},
+		"post-504-not-retried": {Method: http.MethodPost, Status: http.StatusGatewayTimeout, Hits: 1},
+	}
+
+	for testname, tt := range tests {
+		t.Run(testname, func(t *testing.T) {
+			hits := 0
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				hits++
+				w.WriteHeader(tt.Status)
+			}))
+			defer srv.Close()
+
+			client := &http.Client{
+				Transport: &RetryTransport{
+					Base:       http.DefaultTransport,
+					MaxRetries: 2,
+					MinWait:    time.Millisecond,
+					MaxWait:    time.Millisecond,
+				},
+			}
+			req, _ := http.NewRequest(tt.Method, srv.URL, strings.NewReader("{}"))
+			res, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			_ = res.Body.Close()
+
+			if res.StatusCode != tt.Status {
+				t.Fatalf("expected status %d, got %d", tt.Status, res.StatusCode)
+			}
+			if hits != tt.Hits {
+				t.Fatalf("expected %d hits, got %d", tt.Hits, hits)
+			}
+		})
+	}
+}
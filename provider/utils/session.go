@@ -0,0 +1,102 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var nonceRegex = regexp.MustCompile(`([0-9a-f]{64})`)
+
+// GetNonceAndSession fetches a fresh nonce and session from CTFd.
+// It replaces api.GetNonceAndSession that can't be configured with a
+// transport (e.g. for TLS or proxy settings).
+func GetNonceAndSession(ctx context.Context, transport http.RoundTripper, ctfdURL string) (nonce, session string, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ctfdURL+"/setup", nil)
+	if err != nil {
+		return "", "", err
+	}
+	res, err := (&http.Client{Transport: transport}).Do(req)
+	if err != nil {
+		return "", "", err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	nonce, err = getNonce(res.Body)
+	if err != nil {
+		return "", "", err
+	}
+	for _, cookie := range res.Cookies() {
+		if cookie.Name == "session" {
+			return nonce, cookie.Value, nil
+		}
+	}
+	return "", "", errors.New("session cookie not found")
+}
+
+// Login authenticates to CTFd and returns the nonce and session of the
+// logged-in user.
+// It replaces api.Client.Login that does not go through the transport.
+func Login(ctx context.Context, transport http.RoundTripper, ctfdURL, nonce, session, name, password string) (string, string, error) {
+	u, err := url.Parse(ctfdURL)
+	if err != nil {
+		return "", "", err
+	}
+	jar, _ := cookiejar.New(nil)
+	jar.SetCookies(u, []*http.Cookie{{Name: "session", Value: session}})
+
+	val := url.Values{}
+	val.Set("name", name)
+	val.Set("password", password)
+	val.Set("nonce", nonce)
+	val.Set("_submit", "Submit")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ctfdURL+"/login", strings.NewReader(val.Encode()))
+	if err != nil {
+		return "", "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	res, err := (&http.Client{Transport: transport, Jar: jar}).Do(req)
+	if err != nil {
+		return "", "", err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if res.StatusCode != http.StatusOK {
+		return "", "", fmt.Errorf("CTFd responded with status code %d", res.StatusCode)
+	}
+
+	nonce, err = getNonce(res.Body)
+	if err != nil {
+		return "", "", err
+	}
+	for _, cookie := range jar.Cookies(u) {
+		if cookie.Name == "session" {
+			session = cookie.Value
+			break
+		}
+	}
+	return nonce, session, nil
+}
+
+func getNonce(r io.Reader) (string, error) {
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	match := nonceRegex.Find(body)
+	if match == nil {
+		return "", errors.New("nonce not found")
+	}
+	return string(match), nil
+}
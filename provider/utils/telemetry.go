@@ -0,0 +1,206 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/AlexEreh/terraform-provider-ctfd"
+
+// StartSpan starts the parent span of a resource or data source
+// operation, named after both (e.g. "ctfd_challenge.Create").
+// All calls to CTFd issued with the returned context are nested in it.
+func StartSpan(ctx context.Context, name, operation string) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name+"."+operation)
+}
+
+// EndSpan ends the span, marking it as errored if the operation
+// produced error diagnostics.
+func EndSpan(span trace.Span, diags *diag.Diagnostics) {
+	if diags.HasError() {
+		summaries := []string{}
+		for _, d := range diags.Errors() {
+			summaries = append(summaries, d.Summary()+": "+d.Detail())
+		}
+		span.SetStatus(codes.Error, strings.Join(summaries, "; "))
+	}
+	span.End()
+}
+
+const (
+	ProtocolGRPC = "grpc"
+	ProtocolHTTP = "http/protobuf"
+)
+
+// TelemetryConfig configures the export of the spans and metrics of the
+// provider to an OpenTelemetry collector, through OTLP.
+// Unset values default to the standard OTEL_* environment variables,
+// which also configure what is not exposed here (e.g. headers, timeouts).
+type TelemetryConfig struct {
+	// Endpoint is the base URL of the collector
+	// (e.g. http://localhost:4317).
+	Endpoint string
+	// Protocol is either ProtocolGRPC or ProtocolHTTP.
+	Protocol string
+	// Version of the provider, reported as the service version.
+	Version string
+}
+
+var (
+	telemetryMx       sync.Mutex
+	telemetryShutdown func(context.Context) error
+)
+
+// SetupTelemetry registers the global tracer and meter providers, such
+// that spans and metrics are exported.
+// Telemetry is only exported if an endpoint is configured either way,
+// and OTEL_SDK_DISABLED is not true. It is set up once per process.
+func SetupTelemetry(ctx context.Context, conf TelemetryConfig) error {
+	telemetryMx.Lock()
+	defer telemetryMx.Unlock()
+
+	if telemetryShutdown != nil || !telemetryEnabled(conf) {
+		return nil
+	}
+
+	protocol := conf.Protocol
+	if protocol == "" {
+		protocol = firstEnv(ProtocolHTTP, "OTEL_EXPORTER_OTLP_PROTOCOL")
+	}
+	traceExp, metricExp, err := otlpExporters(ctx, conf.Endpoint, protocol)
+	if err != nil {
+		return err
+	}
+
+	// Environment (e.g. OTEL_SERVICE_NAME) takes precedence
+	res, err := resource.New(ctx,
+		resource.WithAttributes(
+			attribute.String("service.name", "terraform-provider-ctfd"),
+			attribute.String("service.version", conf.Version),
+		),
+		resource.WithTelemetrySDK(),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return err
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(traceExp),
+		sdktrace.WithResource(res),
+	)
+	mp := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(metricExp)),
+		sdkmetric.WithResource(res),
+	)
+	otel.SetTracerProvider(tp)
+	otel.SetMeterProvider(mp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	telemetryShutdown = func(ctx context.Context) error {
+		// Flushes the pending spans and metrics
+		return errors.Join(tp.Shutdown(ctx), mp.Shutdown(ctx))
+	}
+	return nil
+}
+
+// ShutdownTelemetry flushes then stops the export of the telemetry, if
+// it was set up. It must be called before the provider exits.
+func ShutdownTelemetry(ctx context.Context) error {
+	telemetryMx.Lock()
+	defer telemetryMx.Unlock()
+
+	if telemetryShutdown == nil {
+		return nil
+	}
+	err := telemetryShutdown(ctx)
+	telemetryShutdown = nil
+	return err
+}
+
+func telemetryEnabled(conf TelemetryConfig) bool {
+	if disabled, _ := strconv.ParseBool(os.Getenv("OTEL_SDK_DISABLED")); disabled {
+		return false
+	}
+	return conf.Endpoint != "" || firstEnv("",
+		"OTEL_EXPORTER_OTLP_ENDPOINT",
+		"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT",
+		"OTEL_EXPORTER_OTLP_METRICS_ENDPOINT",
+	) != ""
+}
+
+// otlpExporters returns the OTLP exporters of the spans and metrics.
+// If endpoint is empty, the exporters read it from the environment.
+func otlpExporters(ctx context.Context, endpoint, protocol string) (sdktrace.SpanExporter, sdkmetric.Exporter, error) {
+	switch protocol {
+	case ProtocolGRPC:
+		traceOpts := []otlptracegrpc.Option{}
+		metricOpts := []otlpmetricgrpc.Option{}
+		if endpoint != "" {
+			traceOpts = append(traceOpts, otlptracegrpc.WithEndpointURL(endpoint))
+			metricOpts = append(metricOpts, otlpmetricgrpc.WithEndpointURL(endpoint))
+		}
+		traceExp, err := otlptracegrpc.New(ctx, traceOpts...)
+		if err != nil {
+			return nil, nil, err
+		}
+		metricExp, err := otlpmetricgrpc.New(ctx, metricOpts...)
+		if err != nil {
+			return nil, nil, errors.Join(err, traceExp.Shutdown(ctx))
+		}
+		return traceExp, metricExp, nil
+
+	case ProtocolHTTP:
+		traceOpts := []otlptracehttp.Option{}
+		metricOpts := []otlpmetrichttp.Option{}
+		if endpoint != "" {
+			// As for OTEL_EXPORTER_OTLP_ENDPOINT, the signal path is appended
+			base := strings.TrimSuffix(endpoint, "/")
+			traceOpts = append(traceOpts, otlptracehttp.WithEndpointURL(base+"/v1/traces"))
+			metricOpts = append(metricOpts, otlpmetrichttp.WithEndpointURL(base+"/v1/metrics"))
+		}
+		traceExp, err := otlptracehttp.New(ctx, traceOpts...)
+		if err != nil {
+			return nil, nil, err
+		}
+		metricExp, err := otlpmetrichttp.New(ctx, metricOpts...)
+		if err != nil {
+			return nil, nil, errors.Join(err, traceExp.Shutdown(ctx))
+		}
+		return traceExp, metricExp, nil
+	}
+	return nil, nil, fmt.Errorf("unsupported OTLP protocol %q, expected %s or %s", protocol, ProtocolGRPC, ProtocolHTTP)
+}
+
+// firstEnv returns the value of the first environment variable set
+// among keys, else def.
+func firstEnv(def string, keys ...string) string {
+	for _, key := range keys {
+		if v := os.Getenv(key); v != "" {
+			return v
+		}
+	}
+	return def
+}
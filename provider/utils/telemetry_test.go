@@ -0,0 +1,90 @@
+package utils
+
+import (
+	"context"
+	"testing"
+)
+
+func Test_U_TelemetryEnabled(t *testing.T) {
+	var tests = map[string]struct {
+		Config TelemetryConfig
+		Env    map[string]string
+		Expect bool
+	}{
+		"none": {
+			Expect: false,
+		},
+		"config": {
+			Config: TelemetryConfig{Endpoint: "http://localhost:4318"},
+			Expect: true,
+		},
+		"env": {
+			Env:    map[string]string{"OTEL_EXPORTER_OTLP_ENDPOINT": "http://localhost:4318"},
+			Expect: true,
+		},
+		"env-traces": {
+			Env:    map[string]string{"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT": "http://localhost:4318/v1/traces"},
+			Expect: true,
+		},
+		"disabled": {
+			Config: TelemetryConfig{Endpoint: "http://localhost:4318"},
+			Env:    map[string]string{"OTEL_SDK_DISABLED": "true"},
+			Expect: false,
+		},
+	}
+
+	for testname, tt := range tests {
+		t.Run(testname, func(t *testing.T) {
+			for _, key := range []string{
+				"OTEL_SDK_DISABLED",
+				"OTEL_EXPORTER_OTLP_ENDPOINT",
+				"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT",
+				"OTEL_EXPORTER_OTLP_METRICS_ENDPOINT",
+			} {
+				t.Setenv(key, tt.Env[key])
+			}
+
+			if enabled := telemetryEnabled(tt.Config); enabled != tt.Expect {
+				t.Fatalf("expected %t, got %t", tt.Expect, enabled)
+			}
+		})
+	}
+}
+
+func Test_U_OTLPExporters(t *testing.T) {
+	var tests = map[string]struct {
+		Protocol  string
+		ExpectErr bool
+	}{
+		"grpc": {
+			Protocol: ProtocolGRPC,
+		},
+		"http": {
+			Protocol: ProtocolHTTP,
+		},
+		"unsupported": {
+			Protocol:  "http/json",
+			ExpectErr: true,
+		},
+	}
+
+	for testname, tt := range tests {
+		t.Run(testname, func(t *testing.T) {
+			ctx := context.Background()
+			traceExp, metricExp, err := otlpExporters(ctx, "http://localhost:4318", tt.Protocol)
+			if (err != nil) != tt.ExpectErr {
+				t.Fatalf("expected error %t, got %v", tt.ExpectErr, err)
+			}
+			if err != nil {
+				return
+			}
+			// Nothing was exported, shutting down does not reach the collector
+			if err := traceExp.Shutdown(ctx); err != nil {
+				t.Errorf("trace exporter shutdown: %s", err)
+			}
+			if err := metricExp.Shutdown(ctx); err != nil {
+				t.Errorf("metric exporter shutdown: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package utils
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// TransportConfig holds the network settings to reach CTFd with.
+type TransportConfig struct {
+	// CACertFile is a path to a PEM-encoded CA bundle.
+	CACertFile string
+	// CACertPEM is a PEM-encoded CA bundle.
+	CACertPEM string
+	// ClientCert and ClientKey are either PEM-encoded contents or paths
+	// to them, for mTLS.
+	ClientCert string
+	ClientKey  string
+
+	InsecureSkipVerify bool
+
+	// ProxyURL overrides the proxy from the environment
+	// (HTTP_PROXY, HTTPS_PROXY and NO_PROXY).
+	ProxyURL string
+}
+
+// NewTransport builds the base HTTP transport to reach CTFd.
+func NewTransport(conf TransportConfig) (*http.Transport, error) {
+	tlsConf := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: conf.InsecureSkipVerify,
+	}
+
+	if conf.CACertFile != "" || conf.CACertPEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if conf.CACertFile != "" {
+			pem, err := os.ReadFile(conf.CACertFile)
+			if err != nil {
+				return nil, fmt.Errorf("reading CA certificate file: %w", err)
+			}
+			if !pool.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("no valid certificate found in %s", conf.CACertFile)
+			}
+		}
+		if conf.CACertPEM != "" && !pool.AppendCertsFromPEM([]byte(conf.CACertPEM)) {
+			return nil, errors.New("no valid certificate found in CA certificate PEM")
+		}
+		tlsConf.RootCAs = pool
+	}
+
+	if conf.ClientCert != "" || conf.ClientKey != "" {
+		if conf.ClientCert == "" || conf.ClientKey == "" {
+			return nil, errors.New("both client certificate and key must be set for mTLS")
+		}
+		cert, err := pemOrFile(conf.ClientCert)
+		if err != nil {
+			return nil, fmt.Errorf("reading client certificate: %w", err)
+		}
+		key, err := pemOrFile(conf.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("reading client key: %w", err)
+		}
+		pair, err := tls.X509KeyPair(cert, key)
+		if err != nil {
+			return nil, fmt.Errorf("loading client key pair: %w", err)
+		}
+		tlsConf.Certificates = []tls.Certificate{pair}
+	}
+
+	tp := http.DefaultTransport.(*http.Transport).Clone()
+	tp.TLSClientConfig = tlsConf
+	if conf.ProxyURL != "" {
+		proxy, err := url.Parse(conf.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("parsing proxy URL: %w", err)
+		}
+		tp.Proxy = http.ProxyURL(proxy)
+	}
+	return tp, nil
+}
+
+// pemOrFile returns the PEM content of str, reading it from the file
+// it points to if it is not PEM-encoded.
+func pemOrFile(str string) ([]byte, error) {
+	if strings.HasPrefix(strings.TrimSpace(str), "-----BEGIN") {
+		return []byte(str), nil
+	}
+	return os.ReadFile(str)
+}
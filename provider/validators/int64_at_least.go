@@ -0,0 +1,48 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Int64AtLeastValidator validates an int64 value is greater than or
+// equal to a minimum.
+type Int64AtLeastValidator struct {
+	minimum int64
+}
+
+func NewInt64AtLeastValidator(minimum int64) *Int64AtLeastValidator {
+	return &Int64AtLeastValidator{
+		minimum: minimum,
+	}
+}
+
+var _ validator.Int64 = (*Int64AtLeastValidator)(nil)
+
+func (val *Int64AtLeastValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("Validates an int64 value is at least %d.", val.minimum)
+}
+
+func (val *Int64AtLeastValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("Validates an int64 value is at least %d.", val.minimum)
+}
+
+func (val *Int64AtLeastValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, res *validator.Int64Response) {
+	if req.ConfigValue.IsNull() {
+		return
+	}
+
+	if req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if req.ConfigValue.ValueInt64() < val.minimum {
+		res.Diagnostics.AddAttributeError(
+			req.Path,
+			"Int64AtLeastValidator Error",
+			fmt.Sprintf("Value must be at least %d, got %d.", val.minimum, req.ConfigValue.ValueInt64()),
+		)
+	}
+}
@@ -0,0 +1,57 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Int64AtMostAttributeValidator validates an int64 value is lower than
+// or equal to the one of another attribute, e.g. a minimum compared to
+// its maximum.
+type Int64AtMostAttributeValidator struct {
+	other path.Path
+}
+
+func NewInt64AtMostAttributeValidator(other path.Path) *Int64AtMostAttributeValidator {
+	return &Int64AtMostAttributeValidator{
+		other: other,
+	}
+}
+
+var _ validator.Int64 = (*Int64AtMostAttributeValidator)(nil)
+
+func (val *Int64AtMostAttributeValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("Validates an int64 value is at most the one of %s.", val.other)
+}
+
+func (val *Int64AtMostAttributeValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("Validates an int64 value is at most the one of `%s`.", val.other)
+}
+
+func (val *Int64AtMostAttributeValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, res *validator.Int64Response) {
+	if req.ConfigValue.IsNull() {
+		return
+	}
+
+	if req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var other types.Int64
+	res.Diagnostics.Append(req.Config.GetAttribute(ctx, val.other, &other)...)
+	if res.Diagnostics.HasError() || other.IsNull() || other.IsUnknown() {
+		return
+	}
+
+	if req.ConfigValue.ValueInt64() > other.ValueInt64() {
+		res.Diagnostics.AddAttributeError(
+			req.Path,
+			"Int64AtMostAttributeValidator Error",
+			fmt.Sprintf("Value must be at most the one of %s (%d), got %d.", val.other, other.ValueInt64(), req.ConfigValue.ValueInt64()),
+		)
+	}
+}
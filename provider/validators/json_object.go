@@ -0,0 +1,45 @@
+package validators
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// JSONObjectValidator validates a string value is a JSON object.
+type JSONObjectValidator struct{}
+
+func NewJSONObjectValidator() *JSONObjectValidator {
+	return &JSONObjectValidator{}
+}
+
+var _ validator.String = (*JSONObjectValidator)(nil)
+
+func (val *JSONObjectValidator) Description(ctx context.Context) string {
+	return "Validates a string value is a JSON object."
+}
+
+func (val *JSONObjectValidator) MarkdownDescription(ctx context.Context) string {
+	return "Validates a string value is a JSON object."
+}
+
+func (val *JSONObjectValidator) ValidateString(ctx context.Context, req validator.StringRequest, res *validator.StringResponse) {
+	if req.ConfigValue.IsNull() {
+		return
+	}
+
+	if req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	obj := map[string]any{}
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &obj); err != nil {
+		res.Diagnostics.AddAttributeError(
+			req.Path,
+			"JSONObjectValidator Error",
+			fmt.Sprintf("Invalid JSON object: %s", err),
+		)
+	}
+}
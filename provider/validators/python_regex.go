@@ -0,0 +1,326 @@
+package validators
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// ErrPythonOnly is returned for constructs of the Python regex dialect
+// that Go's RE2 engine does not support, thus could not be checked.
+var ErrPythonOnly = errors.New("construct only supported by Python")
+
+var (
+	// Python inline flags, "L" is only valid on bytes patterns
+	pythonFlags = regexp.MustCompile(`^\(\?([aimsux]+)(?:-([imsx]+))?([:)])`)
+	// Python accepts missing bounds, which RE2 reads as literals
+	pythonRepeat  = regexp.MustCompile(`^\{([0-9]*)(,?)([0-9]*)\}`)
+	pythonOctal   = regexp.MustCompile(`^(?:0[0-7]{0,2}|[0-7]{3})`)
+	pythonUnicode = regexp.MustCompile(`^(?:u[0-9a-fA-F]{4}|U[0-9a-fA-F]{8})`)
+)
+
+// Python 3 classes of str patterns are Unicode-aware, while RE2 ones are
+// ASCII only. \s matches the characters for which str.isspace is true.
+const (
+	pythonWord       = `\p{L}\p{N}_`
+	pythonDigit      = `\p{Nd}`
+	pythonSpace      = `\t\n\v\f\r\x1c-\x1f\x85\p{Z}`
+	pythonASCIISpace = `\t\n\v\f\r `
+)
+
+// PythonRegex compiles expr as CTFd would with the Python re module,
+// by translating it to the RE2 syntax. As CTFd compares submissions
+// with re.match then checks the match is the whole submission, the
+// returned regexp should be used through PythonRegexMatch.
+// It returns an error wrapping ErrPythonOnly if expr is valid in Python
+// but could not be translated.
+func PythonRegex(expr string, caseInsensitive bool) (*regexp.Regexp, error) {
+	// ASCII-only matching, as with re.ASCII
+	ascii := false
+	if m := pythonFlags.FindStringSubmatch(expr); m != nil && m[3] == ")" {
+		ascii = strings.Contains(m[1], "a")
+	}
+
+	var b strings.Builder
+	inClass := false
+	// Whether the last token written is a greedy quantifier
+	quantified := false
+	for i := 0; i < len(expr); i++ {
+		c := expr[i]
+		rest := expr[i:]
+		afterQuantifier := quantified
+		quantified = false
+
+		switch {
+		case c == '\\':
+			if i+1 == len(expr) {
+				return nil, errors.New("bad escape (end of pattern)")
+			}
+			next := expr[i+1]
+			switch {
+			case next == 'Z':
+				b.WriteString(`\z`)
+			case next == 'z', next == 'p', next == 'P', next == 'Q', next == 'E', next == 'C':
+				return nil, fmt.Errorf("bad escape \\%c, not supported by Python", next)
+			case next == 'x' && i+2 < len(expr) && expr[i+2] == '{':
+				return nil, errors.New(`bad escape \x{, not supported by Python`)
+			case next == 'N':
+				return nil, fmt.Errorf("%w: named unicode characters", ErrPythonOnly)
+			case next == 'g':
+				return nil, fmt.Errorf("%w: backreferences", ErrPythonOnly)
+			case next == 'b' && inClass:
+				// Backspace in a set, RE2 has no such escape
+				b.WriteString(`\x08`)
+			case strings.IndexByte("wWdDsSbB", next) != -1:
+				class, err := pythonClass(next, inClass, ascii)
+				if err != nil {
+					return nil, err
+				}
+				b.WriteString(class)
+			case pythonUnicode.MatchString(expr[i+1:]):
+				m := pythonUnicode.FindString(expr[i+1:])
+				fmt.Fprintf(&b, `\x{%s}`, m[1:])
+				i += len(m) - 1
+			case next >= '0' && next <= '9':
+				if m := pythonOctal.FindString(expr[i+1:]); m != "" {
+					fmt.Fprintf(&b, `\%s`, m)
+					i += len(m) - 1
+					break
+				}
+				if inClass {
+					return nil, fmt.Errorf("bad escape \\%c", next)
+				}
+				return nil, fmt.Errorf("%w: backreferences", ErrPythonOnly)
+			default:
+				b.WriteByte(c)
+				b.WriteByte(next)
+			}
+			i++
+
+		case inClass:
+			switch c {
+			case ']':
+				inClass = false
+				b.WriteByte(c)
+			case '[':
+				// Always a literal in Python, while RE2 would read "[:"
+				// as a POSIX character class
+				b.WriteString(`\[`)
+			default:
+				b.WriteByte(c)
+			}
+
+		case c == '[':
+			inClass = true
+			b.WriteByte(c)
+			// A leading ] (possibly negated) is a literal in both dialects
+			if strings.HasPrefix(rest, "[^]") {
+				b.WriteString("^]")
+				i += 2
+			} else if strings.HasPrefix(rest, "[]") {
+				b.WriteByte(']')
+				i++
+			}
+
+		case c == '{' && pythonRepeat.MatchString(rest) && rest[1] != '}':
+			m := pythonRepeat.FindStringSubmatch(rest)
+			low, high := m[1], m[3]
+			if low == "" {
+				low = "0"
+			}
+			if high != "" {
+				l, lerr := strconv.Atoi(low)
+				h, herr := strconv.Atoi(high)
+				if lerr != nil || herr != nil {
+					return nil, errors.New("the repetition number is too large")
+				}
+				if l > h {
+					return nil, errors.New("min repeat greater than max repeat")
+				}
+			}
+			fmt.Fprintf(&b, "{%s%s%s}", low, m[2], high)
+			i += len(m[0]) - 1
+			quantified = true
+
+		case c == '+' && afterQuantifier:
+			return nil, fmt.Errorf("%w: possessive quantifiers", ErrPythonOnly)
+
+		case c == '*' || c == '+' || c == '?':
+			// A "?" following a quantifier makes it lazy, in both dialects
+			b.WriteByte(c)
+			quantified = !afterQuantifier
+
+		case strings.HasPrefix(rest, "(?"):
+			switch {
+			case strings.HasPrefix(rest, "(?:"), strings.HasPrefix(rest, "(?P<"):
+				b.WriteString("(?")
+				i++
+			case strings.HasPrefix(rest, "(?="), strings.HasPrefix(rest, "(?!"),
+				strings.HasPrefix(rest, "(?<="), strings.HasPrefix(rest, "(?<!"):
+				return nil, fmt.Errorf("%w: lookarounds", ErrPythonOnly)
+			case strings.HasPrefix(rest, "(?P="), strings.HasPrefix(rest, "(?("):
+				return nil, fmt.Errorf("%w: backreferences", ErrPythonOnly)
+			case strings.HasPrefix(rest, "(?>"):
+				return nil, fmt.Errorf("%w: atomic groups", ErrPythonOnly)
+			case strings.HasPrefix(rest, "(?#"):
+				// Comment, skip it
+				end := strings.IndexByte(rest, ')')
+				if end == -1 {
+					return nil, errors.New("missing ), unterminated comment")
+				}
+				i += end
+			case pythonFlags.MatchString(rest):
+				m := pythonFlags.FindStringSubmatch(rest)
+				if strings.Contains(m[1], "x") || strings.Contains(m[2], "x") {
+					return nil, fmt.Errorf("%w: verbose mode", ErrPythonOnly)
+				}
+				if m[3] == ")" && i != 0 {
+					return nil, errors.New("global flags not at the start of the expression")
+				}
+				if m[3] == ":" && strings.Contains(m[1], "a") {
+					return nil, fmt.Errorf("%w: ASCII-only groups", ErrPythonOnly)
+				}
+				// Unicode (the default of Python 3 on strings) and ASCII
+				// modes are handled by the translation of the classes
+				on := strings.NewReplacer("a", "", "u", "").Replace(m[1])
+				switch {
+				case on == "" && m[2] == "" && m[3] == ")":
+					// Nothing left to set
+				case on == "" && m[2] == "":
+					b.WriteString("(?:")
+				default:
+					b.WriteString("(?" + on)
+					if m[2] != "" {
+						b.WriteString("-" + m[2])
+					}
+					b.WriteString(m[3])
+				}
+				i += len(m[0]) - 1
+			default:
+				return nil, fmt.Errorf("unknown extension %s", rest[:min(3, len(rest))])
+			}
+
+		default:
+			b.WriteByte(c)
+		}
+	}
+	if inClass {
+		return nil, errors.New("unterminated character set")
+	}
+
+	flags := ""
+	if caseInsensitive {
+		flags = "(?i)"
+	}
+	re, err := regexp.Compile(flags + "^(?:" + b.String() + ")")
+	if err != nil {
+		// Don't leak the translated expression, which is sensitive
+		var serr *syntax.Error
+		if errors.As(err, &serr) {
+			switch serr.Code {
+			case syntax.ErrInvalidRepeatSize, syntax.ErrLarge, syntax.ErrNestingDepth:
+				// RE2 limits, not Python ones
+				return nil, fmt.Errorf("%w: %s", ErrPythonOnly, serr.Code)
+			}
+			return nil, errors.New(serr.Code.String())
+		}
+		return nil, err
+	}
+	return re, nil
+}
+
+// pythonClass translates the \w, \d, \s and \b escapes (and their
+// negations) of Python, which are Unicode-aware unless ascii is set.
+func pythonClass(escape byte, inClass, ascii bool) (string, error) {
+	if inClass && escape == 'B' {
+		return "", errors.New(`bad escape \B`)
+	}
+	if ascii {
+		// RE2 classes are ASCII already, but \s lacks \v
+		switch {
+		case escape == 's':
+			if inClass {
+				return pythonASCIISpace, nil
+			}
+			return "[" + pythonASCIISpace + "]", nil
+		case escape == 'S' && !inClass:
+			return "[^" + pythonASCIISpace + "]", nil
+		case escape == 'S':
+			return "", fmt.Errorf("%w: negated ASCII spaces in a set", ErrPythonOnly)
+		}
+		return `\` + string(escape), nil
+	}
+
+	switch escape {
+	case 'd':
+		return pythonDigit, nil
+	case 'D':
+		return `\P{Nd}`, nil
+	case 'b', 'B':
+		return "", fmt.Errorf("%w: Unicode word boundaries", ErrPythonOnly)
+	}
+	class := pythonWord
+	if escape == 's' || escape == 'S' {
+		class = pythonSpace
+	}
+	switch {
+	case escape == 'w' || escape == 's':
+		if inClass {
+			return class, nil
+		}
+		return "[" + class + "]", nil
+	case !inClass:
+		return "[^" + class + "]", nil
+	}
+	return "", fmt.Errorf("%w: negated Unicode classes in a set", ErrPythonOnly)
+}
+
+// PythonRegexMatch returns whether a submission matches a regexp
+// returned by PythonRegex, as CTFd would: the match must be the whole
+// submission.
+func PythonRegexMatch(re *regexp.Regexp, submission string) bool {
+	loc := re.FindStringIndex(submission)
+	return loc != nil && loc[1] == len(submission)
+}
+
+// ValidateRegexFlag checks the content of a regex flag compiles as CTFd
+// would, and that its test inputs match it.
+// As both are sensitive, the diagnostics do not contain them.
+func ValidateRegexFlag(contentPath path.Path, content string, caseInsensitive bool, inputsPath path.Path, inputs []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	re, err := PythonRegex(content, caseInsensitive)
+	if err != nil {
+		if errors.Is(err, ErrPythonOnly) {
+			diags.AddAttributeWarning(
+				contentPath,
+				"Unchecked Regex Flag",
+				fmt.Sprintf("The regex could not be checked at plan time (%s), its test inputs are not verified.", err),
+			)
+			return diags
+		}
+		diags.AddAttributeError(
+			contentPath,
+			"Invalid Regex Flag",
+			fmt.Sprintf("The regex is not valid for CTFd: %s", err),
+		)
+		return diags
+	}
+
+	for i, input := range inputs {
+		if !PythonRegexMatch(re, input) {
+			diags.AddAttributeError(
+				inputsPath.AtListIndex(i),
+				"Regex Flag Mismatch",
+				fmt.Sprintf("Test input %d does not match the regex of the flag.", i),
+			)
+		}
+	}
+	return diags
+}
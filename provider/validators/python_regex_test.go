@@ -0,0 +1,303 @@
+package validators
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_U_PythonRegex(t *testing.T) {
+	var tests = map[string]struct {
+		Expr             string
+		CaseInsensitive  bool
+		Match            []string
+		NoMatch          []string
+		ExpectPythonOnly bool
+		ExpectErr        bool
+	}{
+		"literal": {
+			Expr:    `CTF{flag}`,
+			Match:   []string{"CTF{flag}"},
+			NoMatch: []string{"ctf{flag}", "CTF{flag}x", "xCTF{flag}"},
+		},
+		"full-match": {
+			// re.match stops at the first alternative, as RE2 does
+			Expr:    `a|ab`,
+			Match:   []string{"a"},
+			NoMatch: []string{"ab"},
+		},
+		"case-insensitive": {
+			Expr:            `CTF\{[a-z]+\}`,
+			CaseInsensitive: true,
+			Match:           []string{"ctf{FLAG}", "CTF{flag}"},
+		},
+		"inline-flags": {
+			Expr:    `(?i)ctf`,
+			Match:   []string{"CTF"},
+			NoMatch: []string{"CTX"},
+		},
+		"scoped-flags": {
+			Expr:    `(?i:ctf)_x`,
+			Match:   []string{"CTF_x"},
+			NoMatch: []string{"CTF_X"},
+		},
+		"ascii-unicode-flags": {
+			Expr:  `(?au)a`,
+			Match: []string{"a"},
+		},
+		"anchors": {
+			Expr:    `^\Aflag$`,
+			Match:   []string{"flag"},
+			NoMatch: []string{"flag\nflag"},
+		},
+		"end-anchor": {
+			Expr:    `flag\Z`,
+			Match:   []string{"flag"},
+			NoMatch: []string{"flag\n"},
+		},
+		"word-boundary": {
+			Expr:             `\bflag\b`,
+			ExpectPythonOnly: true,
+		},
+		"ascii-word-boundary": {
+			Expr:  `(?a)\bflag\b`,
+			Match: []string{"flag"},
+		},
+		"unicode-word": {
+			Expr:    `CTF\{\w+\}`,
+			Match:   []string{"CTF{héllo}", "CTF{日本_1}"},
+			NoMatch: []string{"CTF{hé llo}"},
+		},
+		"unicode-not-word": {
+			Expr:    `\W`,
+			Match:   []string{"-"},
+			NoMatch: []string{"é"},
+		},
+		"unicode-word-in-set": {
+			Expr:    `[\w-]+`,
+			Match:   []string{"é-a"},
+			NoMatch: []string{"é a"},
+		},
+		"unicode-not-word-in-set": {
+			Expr:             `[\W]`,
+			ExpectPythonOnly: true,
+		},
+		"unicode-digit": {
+			Expr:    `\d+\D`,
+			Match:   []string{"1٣x"},
+			NoMatch: []string{"1٣4"},
+		},
+		"unicode-space": {
+			Expr:    `a\sb\Sc`,
+			Match:   []string{"a\u00a0bxc", "a\vbxc"},
+			NoMatch: []string{"axbxc", "a b c"},
+		},
+		"ascii-word": {
+			Expr:    `(?a)\w+`,
+			Match:   []string{"hello"},
+			NoMatch: []string{"héllo"},
+		},
+		"ascii-space": {
+			Expr:    `(?a)\s`,
+			Match:   []string{"\v"},
+			NoMatch: []string{"\u00a0"},
+		},
+		"ascii-group": {
+			Expr:             `(?a:\w)`,
+			ExpectPythonOnly: true,
+		},
+		"not-boundary-in-set": {
+			Expr:      `[\B]`,
+			ExpectErr: true,
+		},
+		"no-lower-bound": {
+			Expr:    `a{,2}`,
+			Match:   []string{"", "a", "aa"},
+			NoMatch: []string{"aaa"},
+		},
+		"no-bounds": {
+			Expr:  `a{,}`,
+			Match: []string{"", "aaaa"},
+		},
+		"empty-braces": {
+			Expr:    `a{}`,
+			Match:   []string{"a{}"},
+			NoMatch: []string{"a"},
+		},
+		"lazy": {
+			Expr:  `a+?b{1,2}?`,
+			Match: []string{"aab"},
+		},
+		"octal": {
+			Expr:  `\101\0`,
+			Match: []string{"A\x00"},
+		},
+		"octal-in-set": {
+			Expr:  `[\101]`,
+			Match: []string{"A"},
+		},
+		"unicode-escape": {
+			Expr:  `\u00e9\U0001F600`,
+			Match: []string{"é😀"},
+		},
+		"backspace-in-set": {
+			Expr:    `[\b]`,
+			Match:   []string{"\b"},
+			NoMatch: []string{"b"},
+		},
+		"nested-set": {
+			Expr:    `[[:a]`,
+			Match:   []string{"[", ":", "a"},
+			NoMatch: []string{"b"},
+		},
+		"posix-like-set": {
+			Expr:    `[[:alpha:]]`,
+			Match:   []string{"a]", ":]"},
+			NoMatch: []string{"b]", "a"},
+		},
+		"leading-bracket": {
+			Expr:    `[]a][^]b]`,
+			Match:   []string{"]c", "aa"},
+			NoMatch: []string{"a]", "ab"},
+		},
+		"comment": {
+			Expr:  `a(?#comment)b`,
+			Match: []string{"ab"},
+		},
+		"named-group": {
+			Expr:  `(?P<name>a)`,
+			Match: []string{"a"},
+		},
+		"lookahead": {
+			Expr:             `a(?=b)`,
+			ExpectPythonOnly: true,
+		},
+		"lookbehind": {
+			Expr:             `(?<!a)b`,
+			ExpectPythonOnly: true,
+		},
+		"backreference": {
+			Expr:             `(a)\1`,
+			ExpectPythonOnly: true,
+		},
+		"named-backreference": {
+			Expr:             `(?P<x>a)(?P=x)`,
+			ExpectPythonOnly: true,
+		},
+		"atomic-group": {
+			Expr:             `(?>a+)b`,
+			ExpectPythonOnly: true,
+		},
+		"possessive-plus": {
+			Expr:             `a++`,
+			ExpectPythonOnly: true,
+		},
+		"possessive-star": {
+			Expr:             `a*+`,
+			ExpectPythonOnly: true,
+		},
+		"possessive-optional": {
+			Expr:             `a?+`,
+			ExpectPythonOnly: true,
+		},
+		"possessive-repeat": {
+			Expr:             `a{2}+`,
+			ExpectPythonOnly: true,
+		},
+		"verbose": {
+			Expr:             `(?x) a b`,
+			ExpectPythonOnly: true,
+		},
+		"large-repeat": {
+			Expr:             `a{2000}`,
+			ExpectPythonOnly: true,
+		},
+		"named-unicode": {
+			Expr:             `\N{LATIN SMALL LETTER A}`,
+			ExpectPythonOnly: true,
+		},
+		"escaped-plus": {
+			Expr:  `a\++`,
+			Match: []string{"a++"},
+		},
+		"plus-in-set": {
+			Expr:  `[a+]+`,
+			Match: []string{"a+a"},
+		},
+		"bad-escape": {
+			Expr:      `\z`,
+			ExpectErr: true,
+		},
+		"trailing-escape": {
+			Expr:      `a\`,
+			ExpectErr: true,
+		},
+		"posix-escape": {
+			Expr:      `\pL`,
+			ExpectErr: true,
+		},
+		"unterminated-set": {
+			Expr:      `[a`,
+			ExpectErr: true,
+		},
+		"unterminated-comment": {
+			Expr:      `(?#a`,
+			ExpectErr: true,
+		},
+		"unbalanced": {
+			Expr:      `(a`,
+			ExpectErr: true,
+		},
+		"reversed-repeat": {
+			Expr:      `a{3,2}`,
+			ExpectErr: true,
+		},
+		"multiple-repeat": {
+			Expr:      `a**`,
+			ExpectErr: true,
+		},
+		"late-global-flags": {
+			Expr:      `a(?i)`,
+			ExpectErr: true,
+		},
+		"unknown-extension": {
+			Expr:      `(?<a>b)`,
+			ExpectErr: true,
+		},
+	}
+
+	for testname, tt := range tests {
+		t.Run(testname, func(t *testing.T) {
+			re, err := PythonRegex(tt.Expr, tt.CaseInsensitive)
+
+			if tt.ExpectPythonOnly {
+				if !errors.Is(err, ErrPythonOnly) {
+					t.Fatalf("expected ErrPythonOnly, got %v", err)
+				}
+				return
+			}
+			if tt.ExpectErr {
+				if err == nil {
+					t.Fatal("expected an error, got none")
+				}
+				if errors.Is(err, ErrPythonOnly) {
+					t.Fatalf("expected a hard error, got %s", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			for _, sub := range tt.Match {
+				if !PythonRegexMatch(re, sub) {
+					t.Errorf("expected %q to match", sub)
+				}
+			}
+			for _, sub := range tt.NoMatch {
+				if PythonRegexMatch(re, sub) {
+					t.Errorf("expected %q not to match", sub)
+				}
+			}
+		})
+	}
+}
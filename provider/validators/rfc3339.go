@@ -0,0 +1,44 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// RFC3339Validator validates a string value is an RFC3339 timestamp.
+type RFC3339Validator struct{}
+
+func NewRFC3339Validator() *RFC3339Validator {
+	return &RFC3339Validator{}
+}
+
+var _ validator.String = (*RFC3339Validator)(nil)
+
+func (val *RFC3339Validator) Description(ctx context.Context) string {
+	return "Validates a string value is an RFC3339 timestamp."
+}
+
+func (val *RFC3339Validator) MarkdownDescription(ctx context.Context) string {
+	return "Validates a string value is an RFC3339 timestamp."
+}
+
+func (val *RFC3339Validator) ValidateString(ctx context.Context, req validator.StringRequest, res *validator.StringResponse) {
+	if req.ConfigValue.IsNull() {
+		return
+	}
+
+	if req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		res.Diagnostics.AddAttributeError(
+			req.Path,
+			"RFC3339Validator Error",
+			fmt.Sprintf("Invalid RFC3339 timestamp: %s", err),
+		)
+	}
+}